@@ -0,0 +1,89 @@
+package cal
+
+import (
+	"math"
+	"time"
+)
+
+// Julian days count days from noon 1 January 4713 BCE in the proleptic
+// Julian calendar. The astronomical formulas, taken from Jean Meeus'
+// Astronomical Algorithms, work with them.
+const julianDayUnixEpoch = 2440587.5
+
+func timeFromJulianDay(jd float64) time.Time {
+	seconds := (jd - julianDayUnixEpoch) * secondsInDay
+	return time.Unix(0, 0).Add(time.Duration(seconds * float64(time.Second))).UTC()
+}
+
+func julianDayFromTime(t time.Time) float64 {
+	return float64(t.UnixNano())/float64(time.Second)/secondsInDay + julianDayUnixEpoch
+}
+
+// deltaT estimates the difference between Terrestrial Time and Universal
+// Time in seconds with the polynomials of Espenak and Meeus.
+func deltaT(year float64) float64 {
+	switch {
+	case year < -500:
+		u := (year - 1820) / 100
+		return -20 + 32*u*u
+	case year < 500:
+		u := year / 100
+		return polynomial(u, 10583.6, -1014.41, 33.78311, -5.952053, -0.1798452, 0.022174192, 0.0090316521)
+	case year < 1600:
+		u := (year - 1000) / 100
+		return polynomial(u, 1574.2, -556.01, 71.23472, 0.319781, -0.8503463, -0.005050998, 0.0083572073)
+	case year < 1700:
+		return polynomial(year-1600, 120, -0.9808, -0.01532, 1.0/7129)
+	case year < 1800:
+		return polynomial(year-1700, 8.83, 0.1603, -0.0059285, 0.00013336, -1.0/1174000)
+	case year < 1860:
+		return polynomial(year-1800, 13.72, -0.332447, 0.0068612, 0.0041116, -0.00037436, 0.0000121272, -0.0000001699, 0.000000000875)
+	case year < 1900:
+		return polynomial(year-1860, 7.62, 0.5737, -0.251754, 0.01680668, -0.0004473624, 1.0/233174)
+	case year < 1920:
+		return polynomial(year-1900, -2.79, 1.494119, -0.0598939, 0.0061966, -0.000197)
+	case year < 1941:
+		return polynomial(year-1920, 21.20, 0.84493, -0.076100, 0.0020936)
+	case year < 1961:
+		return polynomial(year-1950, 29.07, 0.407, -1.0/233, 1.0/2547)
+	case year < 1986:
+		return polynomial(year-1975, 45.45, 1.067, -1.0/260, -1.0/718)
+	case year < 2005:
+		return polynomial(year-2000, 63.86, 0.3345, -0.060374, 0.0017275, 0.000651814, 0.00002373599)
+	case year < 2050:
+		return polynomial(year-2000, 62.92, 0.32217, 0.005589)
+	case year < 2150:
+		u := (year - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-year)
+	}
+	u := (year - 1820) / 100
+	return -20 + 32*u*u
+}
+
+// timeFromEphemerisDay converts a Julian Ephemeris Day, which counts
+// Terrestrial Time, into Universal Time.
+func timeFromEphemerisDay(jde float64) time.Time {
+	t := timeFromJulianDay(jde)
+	year := float64(t.Year()) + float64(t.YearDay())/(DaysInYear+1)
+	return t.Add(-time.Duration(deltaT(year) * float64(time.Second)))
+}
+
+func polynomial(x float64, coefficients ...float64) float64 {
+	result := 0.0
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		result = result*x + coefficients[i]
+	}
+	return result
+}
+
+func degreesToRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func sinDegrees(degrees float64) float64 {
+	return math.Sin(degreesToRadians(degrees))
+}
+
+func cosDegrees(degrees float64) float64 {
+	return math.Cos(degreesToRadians(degrees))
+}
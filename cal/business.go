@@ -0,0 +1,140 @@
+package cal
+
+import "errors"
+
+// A BusinessCalendar decides which IFC days are working days. Weekend
+// lists the weekdays off; Leap Day and Year Day are not weekdays and are
+// days off unless the corresponding flag is set. Holidays, if set, are
+// days off as well.
+type BusinessCalendar struct {
+	Weekend             []Weekday
+	Holidays            HolidayProvider
+	LeapDayIsWorkingDay bool
+	YearDayIsWorkingDay bool
+}
+
+// StandardBusinessCalendar works from Monday to Friday and has no
+// holidays.
+var StandardBusinessCalendar = &BusinessCalendar{
+	Weekend: []Weekday{Saturday, Sunday},
+}
+
+// ErrNoWorkingWeekdays is returned when business days are added in a
+// calendar whose weekend covers every weekday.
+var ErrNoWorkingWeekdays = errors.New("cal: business calendar has no working weekdays")
+
+// businessDays checks dates against a calendar, looking up the holidays
+// of each year only once.
+type businessDays struct {
+	calendar *BusinessCalendar
+	holidays map[int]map[int]bool
+}
+
+func (c *BusinessCalendar) days() *businessDays {
+	return &businessDays{calendar: c, holidays: make(map[int]map[int]bool)}
+}
+
+func (b *businessDays) isHoliday(date *IFCDate) bool {
+	if b.calendar.Holidays == nil {
+		return false
+	}
+
+	holidays, ok := b.holidays[date.Year]
+	if !ok {
+		holidays = make(map[int]bool)
+		for _, holiday := range b.calendar.Holidays.Holidays(date.Year) {
+			holidays[holiday.Date.dayOfYear] = true
+		}
+		b.holidays[date.Year] = holidays
+	}
+	return holidays[date.dayOfYear]
+}
+
+func (b *businessDays) isBusinessDay(date *IFCDate) bool {
+	switch weekday := date.Weekday(); weekday {
+	case LeapDay:
+		if !b.calendar.LeapDayIsWorkingDay {
+			return false
+		}
+	case YearDay:
+		if !b.calendar.YearDayIsWorkingDay {
+			return false
+		}
+	default:
+		for _, dayOff := range b.calendar.Weekend {
+			if weekday == dayOff {
+				return false
+			}
+		}
+	}
+	return !b.isHoliday(date)
+}
+
+// hasWorkingWeekdays reports whether any weekday is outside the weekend.
+// Without one, business days could not be counted.
+func (c *BusinessCalendar) hasWorkingWeekdays() bool {
+	dayOff := make(map[Weekday]bool)
+	for _, weekday := range c.Weekend {
+		dayOff[weekday] = true
+	}
+	for weekday := Sunday; weekday <= Saturday; weekday++ {
+		if !dayOff[weekday] {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *BusinessCalendar) IsBusinessDay(date *IFCDate) bool {
+	return c.days().isBusinessDay(date)
+}
+
+// AddBusinessDays returns the date that is the given number of business
+// days after date, or before it if days is negative. The start date itself
+// is not counted, so adding zero days returns the date unchanged. It
+// returns ErrNoWorkingWeekdays if the weekend covers every weekday.
+func (c *BusinessCalendar) AddBusinessDays(date *IFCDate, days int) (*IFCDate, error) {
+	if days == 0 {
+		return date, nil
+	}
+	if !c.hasWorkingWeekdays() {
+		return nil, ErrNoWorkingWeekdays
+	}
+
+	step := 1
+	if days < 0 {
+		step, days = -1, -days
+	}
+
+	b := c.days()
+	fixed := date.Fixed()
+	for days > 0 {
+		fixed += step
+		if b.isBusinessDay(DateFromFixed(fixed)) {
+			days--
+		}
+	}
+	return DateFromFixed(fixed), nil
+}
+
+// BusinessDaysBetween counts the business days after start up to and
+// including end. The count is negative if end is before start, so that
+// AddBusinessDays(start, BusinessDaysBetween(start, end)) is end whenever
+// end is a business day.
+func (c *BusinessCalendar) BusinessDaysBetween(start *IFCDate, end *IFCDate) int {
+	// Going backwards, end is counted and start is not, as in
+	// AddBusinessDays.
+	first, last, sign := start.Fixed()+1, end.Fixed(), 1
+	if last < first {
+		first, last, sign = end.Fixed(), start.Fixed()-1, -1
+	}
+
+	b := c.days()
+	count := 0
+	for fixed := first; fixed <= last; fixed++ {
+		if b.isBusinessDay(DateFromFixed(fixed)) {
+			count++
+		}
+	}
+	return sign * count
+}
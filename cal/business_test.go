@@ -0,0 +1,74 @@
+package cal_test
+
+import (
+	"testing"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestIsBusinessDay(t *testing.T) {
+	withHolidays := &cal.BusinessCalendar{
+		Weekend:             []cal.Weekday{cal.Saturday, cal.Sunday},
+		Holidays:            cal.FinnishHolidays,
+		LeapDayIsWorkingDay: true,
+	}
+
+	for i, input := range []struct {
+		calendar *cal.BusinessCalendar
+		date     *cal.IFCDate
+		result   bool
+	}{
+		{cal.StandardBusinessCalendar, cal.NewIFCDate(2022, cal.January, 2), true},
+		{cal.StandardBusinessCalendar, cal.NewIFCDate(2022, cal.January, 7), false},
+		{cal.StandardBusinessCalendar, cal.NewIFCDate(2022, cal.January, 8), false},
+		{cal.StandardBusinessCalendar, cal.NewIFCDate(2022, cal.December, 29), false},
+		{cal.StandardBusinessCalendar, cal.NewIFCDate(2024, cal.June, 29), false},
+		{withHolidays, cal.NewIFCDate(2024, cal.June, 29), true},
+		{withHolidays, cal.NewIFCDate(2022, cal.January, 6), false},
+		{withHolidays, cal.NewIFCDate(2022, cal.January, 5), true},
+	} {
+		if result := input.calendar.IsBusinessDay(input.date); result != input.result {
+			t.Errorf("%d: Expected %t for %+v but found %t\n", i, input.result, input.date, result)
+		}
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	yearDayWorking := &cal.BusinessCalendar{
+		Weekend:             []cal.Weekday{cal.Saturday, cal.Sunday},
+		YearDayIsWorkingDay: true,
+	}
+
+	for i, input := range []struct {
+		calendar *cal.BusinessCalendar
+		date     *cal.IFCDate
+		days     int
+		result   *cal.IFCDate
+	}{
+		{cal.StandardBusinessCalendar, cal.NewIFCDate(2022, cal.December, 26), 5, cal.NewIFCDate(2023, cal.January, 5)},
+		{yearDayWorking, cal.NewIFCDate(2022, cal.December, 26), 5, cal.NewIFCDate(2023, cal.January, 4)},
+		{cal.StandardBusinessCalendar, cal.NewIFCDate(2022, cal.March, 2), 20, cal.NewIFCDate(2022, cal.April, 2)},
+		{cal.StandardBusinessCalendar, cal.NewIFCDate(2023, cal.January, 5), -5, cal.NewIFCDate(2022, cal.December, 26)},
+		{cal.StandardBusinessCalendar, cal.NewIFCDate(2022, cal.January, 7), 0, cal.NewIFCDate(2022, cal.January, 7)},
+	} {
+		result, err := input.calendar.AddBusinessDays(input.date, input.days)
+		if err != nil {
+			t.Fatalf("%d: Error adding business days: %s", i, err)
+		}
+		if !result.Equal(input.result) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.result, result)
+		}
+		if days := input.calendar.BusinessDaysBetween(input.date, result); days != input.days {
+			t.Errorf("%d: Expected %d business days between dates but found %d\n", i, input.days, days)
+		}
+	}
+}
+
+func TestAddBusinessDaysWithoutWorkingWeekdays(t *testing.T) {
+	calendar := &cal.BusinessCalendar{
+		Weekend: []cal.Weekday{cal.Sunday, cal.Monday, cal.Tuesday, cal.Wednesday, cal.Thursday, cal.Friday, cal.Saturday},
+	}
+	if _, err := calendar.AddBusinessDays(cal.NewIFCDate(2022, cal.January, 1), 1); err != cal.ErrNoWorkingWeekdays {
+		t.Errorf("Expected %s but found %v\n", cal.ErrNoWorkingWeekdays, err)
+	}
+}
@@ -32,6 +32,10 @@ const (
 	December
 )
 
+// LongMonthNames are the English month names.
+//
+// Deprecated: Use EnglishLocale().MonthName or the MonthName method of
+// another Locale. Changing this slice does not change the names.
 var LongMonthNames = []string{
 	"January",
 	"February",
@@ -50,7 +54,7 @@ var LongMonthNames = []string{
 
 func (m IFCMonth) String() string {
 	if m > 0 && m <= MonthsInYear {
-		return LongMonthNames[int(m)-1]
+		return english.MonthName(m)
 	}
 	return fmt.Sprintf("%%!IFCMonth(%d)", int(m))
 }
@@ -84,41 +88,17 @@ const (
 	YearDay
 )
 
-var longWeekdayNames = []string{
-	"Sunday",
-	"Monday",
-	"Tuesday",
-	"Wednesday",
-	"Thursday",
-	"Friday",
-	"Saturday",
-	"Leap Day",
-	"Year Day",
-}
-
-var shortWeekdayNames = []string{
-	"Su",
-	"Mo",
-	"Tu",
-	"We",
-	"Th",
-	"Fr",
-	"Sa",
-	"LD",
-	"YD",
-}
-
 func (wd Weekday) String() string {
 	if wd >= 0 && wd < 9 {
-		return longWeekdayNames[wd]
+		return english.WeekdayName(wd)
 	}
 
 	return fmt.Sprintf("%%!Weekday(%d)", int(wd))
 }
 
 func (wd Weekday) ShortFormat() string {
-	if wd >= 0 && wd < 8 {
-		return shortWeekdayNames[wd]
+	if wd >= 0 && wd < 9 {
+		return english.ShortWeekdayName(wd)
 	}
 
 	return fmt.Sprintf("%%!Weekday(%d)", int(wd))
@@ -203,6 +183,31 @@ func (d *IFCDate) MinusMonths(months int) *IFCDate {
 	return d.PlusMonths(-months)
 }
 
+func (d *IFCDate) PlusDays(days int) *IFCDate {
+	if days == 0 {
+		return d
+	}
+	return DateFromFixed(d.Fixed() + days)
+}
+
+func (d *IFCDate) MinusDays(days int) *IFCDate {
+	return d.PlusDays(-days)
+}
+
+func (d *IFCDate) Before(other *IFCDate) bool {
+	return d.Year < other.Year || d.Year == other.Year && d.dayOfYear < other.dayOfYear
+}
+
+func (d *IFCDate) After(other *IFCDate) bool {
+	return other.Before(d)
+}
+
+// DaysBetween returns the number of days from start to end, which is
+// negative if end is before start.
+func DaysBetween(start *IFCDate, end *IFCDate) int {
+	return end.Fixed() - start.Fixed()
+}
+
 func DateAt(t time.Time) *IFCDate {
 	year, month, day, dayOfYear := date(t)
 	return &IFCDate{
@@ -191,3 +191,73 @@ func TestMinusMonthsFromDate(t *testing.T) {
 		}
 	}
 }
+
+func TestAddDaysToDate(t *testing.T) {
+	for i, input := range []struct {
+		ifcDate   *cal.IFCDate
+		daysToAdd int
+		result    *cal.IFCDate
+	}{
+		{
+			cal.NewIFCDate(2021, cal.January, 1),
+			28,
+			cal.NewIFCDate(2021, cal.February, 1),
+		},
+		{
+			cal.NewIFCDate(2020, cal.June, 28),
+			1,
+			cal.NewIFCDate(2020, cal.June, 29),
+		},
+		{
+			cal.NewIFCDate(2020, cal.June, 29),
+			1,
+			cal.NewIFCDate(2020, cal.Sol, 1),
+		},
+		{
+			cal.NewIFCDate(2021, cal.December, 28),
+			2,
+			cal.NewIFCDate(2022, cal.January, 1),
+		},
+		{
+			cal.NewIFCDate(2022, cal.January, 1),
+			-1,
+			cal.NewIFCDate(2021, cal.December, 29),
+		},
+		{
+			cal.NewIFCDate(2021, cal.March, 3),
+			-366,
+			cal.NewIFCDate(2020, cal.March, 3),
+		},
+	} {
+		newDate := input.ifcDate.PlusDays(input.daysToAdd)
+		if !newDate.Equal(input.result) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.result, newDate)
+		}
+		if days := cal.DaysBetween(input.ifcDate, newDate); days != input.daysToAdd {
+			t.Errorf("%d: Expected %d days between dates but found %d\n", i, input.daysToAdd, days)
+		}
+		if input.daysToAdd > 0 && !newDate.After(input.ifcDate) || input.daysToAdd < 0 && !newDate.Before(input.ifcDate) {
+			t.Errorf("%d: Expected %+v and %+v in a different order\n", i, input.ifcDate, newDate)
+		}
+	}
+}
+
+func TestEraYearFormatting(t *testing.T) {
+	for i, input := range []struct {
+		era    cal.Era
+		year   int
+		result string
+	}{
+		{cal.CommonEra, 2022, "2022"},
+		{cal.HoloceneEra, 2022, "12022 HE"},
+		{cal.HoloceneEra, -9000, "1000 HE"},
+	} {
+		formatted := input.era.FormatYear(input.year)
+		if formatted != input.result {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, input.result, formatted)
+		}
+		if input.era.CommonEraYear(input.era.Year(input.year)) != input.year {
+			t.Errorf("%d: Year %d did not survive a round trip\n", i, input.year)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package cal
+
+import (
+	"fmt"
+	"time"
+)
+
+// The Coptic and Ethiopian calendars have twelve months of 30 days followed
+// by a short thirteenth month of five days, or six in leap years. Every
+// fourth year is a leap year. The calendars differ only in their epochs and
+// month names.
+const (
+	copticEpoch    = 103605 // 29 August 284 (Julian)
+	ethiopianEpoch = 2796   // 29 August 8 (Julian)
+
+	alexandrianMonthLength = 30
+)
+
+type CopticMonth int
+
+const (
+	Thout CopticMonth = 1 + iota
+	Paopi
+	Hathor
+	Koiak
+	Tobi
+	Meshir
+	Paremhat
+	Parmouti
+	Pashons
+	Paoni
+	Epip
+	Mesori
+	PiKogiEnavot
+)
+
+var copticMonthNames = []string{
+	"Thout",
+	"Paopi",
+	"Hathor",
+	"Koiak",
+	"Tobi",
+	"Meshir",
+	"Paremhat",
+	"Parmouti",
+	"Pashons",
+	"Paoni",
+	"Epip",
+	"Mesori",
+	"Pi Kogi Enavot",
+}
+
+func (m CopticMonth) String() string {
+	if m > 0 && m <= MonthsInYear {
+		return copticMonthNames[int(m)-1]
+	}
+	return fmt.Sprintf("%%!CopticMonth(%d)", int(m))
+}
+
+type EthiopianMonth int
+
+const (
+	Meskerem EthiopianMonth = 1 + iota
+	Tekemt
+	Hedar
+	Tahsas
+	Ter
+	Yekatit
+	Megabit
+	Miazia
+	Genbot
+	Sene
+	Hamle
+	Nehasse
+	Pagume
+)
+
+var ethiopianMonthNames = []string{
+	"Meskerem",
+	"Tekemt",
+	"Hedar",
+	"Tahsas",
+	"Ter",
+	"Yekatit",
+	"Megabit",
+	"Miazia",
+	"Genbot",
+	"Sene",
+	"Hamle",
+	"Nehasse",
+	"Pagume",
+}
+
+func (m EthiopianMonth) String() string {
+	if m > 0 && m <= MonthsInYear {
+		return ethiopianMonthNames[int(m)-1]
+	}
+	return fmt.Sprintf("%%!EthiopianMonth(%d)", int(m))
+}
+
+func isAlexandrianLeapYear(year int) bool {
+	return mod(year, 4) == 3
+}
+
+func daysInAlexandrianMonth(year int, month int) int {
+	if month < MonthsInYear {
+		return alexandrianMonthLength
+	}
+	if isAlexandrianLeapYear(year) {
+		return 6
+	}
+	return 5
+}
+
+func fixedFromAlexandrian(epoch int, year int, month int, day int) int {
+	return epoch - 1 + 365*(year-1) + floorDiv(year, 4) + alexandrianMonthLength*(month-1) + day
+}
+
+func alexandrianFromFixed(epoch int, fixed int) (year int, month int, day int) {
+	year = floorDiv(4*(fixed-epoch)+1463, 1461)
+	month = (fixed-fixedFromAlexandrian(epoch, year, 1, 1))/alexandrianMonthLength + 1
+	day = fixed + 1 - fixedFromAlexandrian(epoch, year, month, 1)
+	return
+}
+
+type CopticDate struct {
+	Year  int
+	Month CopticMonth
+	Day   int
+}
+
+func NewCopticDate(year int, month CopticMonth, day int) *CopticDate {
+	return &CopticDate{Year: year, Month: month, Day: day}
+}
+
+func CopticDateAt(t time.Time) *CopticDate {
+	return CopticDateFromFixed(FixedFromTime(t))
+}
+
+func CopticDateFromFixed(fixed int) *CopticDate {
+	year, month, day := alexandrianFromFixed(copticEpoch, fixed)
+	return NewCopticDate(year, CopticMonth(month), day)
+}
+
+func (d *CopticDate) Fixed() int {
+	return fixedFromAlexandrian(copticEpoch, d.Year, int(d.Month), d.Day)
+}
+
+func (d *CopticDate) ToUTCTime() time.Time {
+	return TimeFromFixed(d.Fixed())
+}
+
+func (d *CopticDate) ToIFCDate() *IFCDate {
+	return DateFromFixed(d.Fixed())
+}
+
+func (d *CopticDate) Equal(other *CopticDate) bool {
+	return d.Day == other.Day && d.Month == other.Month && d.Year == other.Year
+}
+
+func IsCopticLeapYear(year int) bool {
+	return isAlexandrianLeapYear(year)
+}
+
+func DaysInCopticMonth(year int, month CopticMonth) int {
+	return daysInAlexandrianMonth(year, int(month))
+}
+
+type EthiopianDate struct {
+	Year  int
+	Month EthiopianMonth
+	Day   int
+}
+
+func NewEthiopianDate(year int, month EthiopianMonth, day int) *EthiopianDate {
+	return &EthiopianDate{Year: year, Month: month, Day: day}
+}
+
+func EthiopianDateAt(t time.Time) *EthiopianDate {
+	return EthiopianDateFromFixed(FixedFromTime(t))
+}
+
+func EthiopianDateFromFixed(fixed int) *EthiopianDate {
+	year, month, day := alexandrianFromFixed(ethiopianEpoch, fixed)
+	return NewEthiopianDate(year, EthiopianMonth(month), day)
+}
+
+func (d *EthiopianDate) Fixed() int {
+	return fixedFromAlexandrian(ethiopianEpoch, d.Year, int(d.Month), d.Day)
+}
+
+func (d *EthiopianDate) ToUTCTime() time.Time {
+	return TimeFromFixed(d.Fixed())
+}
+
+func (d *EthiopianDate) ToIFCDate() *IFCDate {
+	return DateFromFixed(d.Fixed())
+}
+
+func (d *EthiopianDate) Equal(other *EthiopianDate) bool {
+	return d.Day == other.Day && d.Month == other.Month && d.Year == other.Year
+}
+
+func IsEthiopianLeapYear(year int) bool {
+	return isAlexandrianLeapYear(year)
+}
+
+func DaysInEthiopianMonth(year int, month EthiopianMonth) int {
+	return daysInAlexandrianMonth(year, int(month))
+}
@@ -0,0 +1,97 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestCopticDateConversion(t *testing.T) {
+	for i, input := range []struct {
+		time       time.Time
+		copticDate *cal.CopticDate
+	}{
+		{
+			time.Date(2021, time.September, 11, 0, 0, 0, 0, time.UTC),
+			cal.NewCopticDate(1738, cal.Thout, 1),
+		},
+		{
+			time.Date(2022, time.January, 7, 0, 0, 0, 0, time.UTC),
+			cal.NewCopticDate(1738, cal.Koiak, 29),
+		},
+		{
+			time.Date(2023, time.September, 11, 0, 0, 0, 0, time.UTC),
+			cal.NewCopticDate(1739, cal.PiKogiEnavot, 6),
+		},
+		{
+			time.Date(2023, time.September, 12, 0, 0, 0, 0, time.UTC),
+			cal.NewCopticDate(1740, cal.Thout, 1),
+		},
+		{
+			time.Date(284, time.August, 29, 0, 0, 0, 0, time.UTC),
+			cal.NewCopticDate(1, cal.Thout, 1),
+		},
+	} {
+		date := cal.CopticDateAt(input.time)
+		if !date.Equal(input.copticDate) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.copticDate, date)
+		}
+
+		timeStamp := input.copticDate.ToUTCTime()
+		if !timeStamp.Equal(input.time) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.time, timeStamp)
+		}
+	}
+}
+
+func TestEthiopianDateConversion(t *testing.T) {
+	for i, input := range []struct {
+		ethiopianDate *cal.EthiopianDate
+		ifcDate       *cal.IFCDate
+	}{
+		{
+			cal.NewEthiopianDate(2016, cal.Meskerem, 1),
+			cal.NewIFCDate(2023, cal.September, 3),
+		},
+		{
+			cal.NewEthiopianDate(2015, cal.Pagume, 6),
+			cal.NewIFCDate(2023, cal.September, 2),
+		},
+		{
+			cal.NewEthiopianDate(2014, cal.Tahsas, 29),
+			cal.NewIFCDate(2022, cal.January, 7),
+		},
+		{
+			cal.NewEthiopianDate(2012, cal.Sene, 10),
+			cal.NewIFCDate(2020, cal.June, 29),
+		},
+	} {
+		ifcDate := input.ethiopianDate.ToIFCDate()
+		if !ifcDate.Equal(input.ifcDate) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.ifcDate, ifcDate)
+		}
+
+		date := cal.EthiopianDateAt(input.ifcDate.ToUTCTime())
+		if !date.Equal(input.ethiopianDate) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.ethiopianDate, date)
+		}
+	}
+}
+
+func TestDaysInEpagomenalMonth(t *testing.T) {
+	for i, input := range []struct {
+		year int
+		days int
+	}{
+		{2015, 6},
+		{2016, 5},
+		{2019, 6},
+		{2020, 5},
+	} {
+		days := cal.DaysInEthiopianMonth(input.year, cal.Pagume)
+		if days != input.days {
+			t.Errorf("%d: Expected %d but found %d\n", i, input.days, days)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package cal
+
+import (
+	"fmt"
+	"time"
+)
+
+// A Computus is a rule for computing the date of Easter.
+type Computus int
+
+const (
+	// GregorianComputus is used by the Western churches.
+	GregorianComputus Computus = iota
+	// OrthodoxComputus computes Easter in the Julian calendar, as the
+	// Eastern Orthodox churches do. The dates are still returned in the
+	// Gregorian calendar and the IFC.
+	OrthodoxComputus
+)
+
+var computusNames = []string{
+	"Gregorian",
+	"Orthodox",
+}
+
+func (c Computus) String() string {
+	if c >= GregorianComputus && c <= OrthodoxComputus {
+		return computusNames[c]
+	}
+	return fmt.Sprintf("%%!Computus(%d)", int(c))
+}
+
+// A Feast is a movable observance with its date in both the Gregorian
+// calendar and the IFC.
+type Feast struct {
+	Name      string
+	Gregorian time.Time
+	IFC       *IFCDate
+}
+
+func newFeast(name string, fixed int) Feast {
+	return Feast{Name: name, Gregorian: TimeFromFixed(fixed), IFC: DateFromFixed(fixed)}
+}
+
+// gregorianWeekdayOnOrBefore returns the last day on or before the fixed
+// day that falls on the given Gregorian weekday. Fixed day 1 is a Monday.
+func gregorianWeekdayOnOrBefore(weekday time.Weekday, fixed int) int {
+	return fixed - mod(fixed-int(weekday), DaysInWeek)
+}
+
+func gregorianWeekdayAfter(weekday time.Weekday, fixed int) int {
+	return gregorianWeekdayOnOrBefore(weekday, fixed+DaysInWeek)
+}
+
+// easterFixed follows the ecclesiastical rules in Calendrical
+// Calculations: Easter is the first Sunday after the paschal full moon,
+// which is found from the epact of the year.
+func (c Computus) easterFixed(year int) int {
+	if c == OrthodoxComputus {
+		shiftedEpact := mod(14+11*mod(year, 19), 30)
+		paschalMoon := fixedFromJulian(year, time.April, 19) - shiftedEpact
+		return gregorianWeekdayAfter(time.Sunday, paschalMoon)
+	}
+
+	century := floorDiv(year, 100) + 1
+	shiftedEpact := mod(14+11*mod(year, 19)-floorDiv(3*century, 4)+floorDiv(5+8*century, 25), 30)
+	adjustedEpact := shiftedEpact
+	if shiftedEpact == 0 || shiftedEpact == 1 && mod(year, 19) > 10 {
+		adjustedEpact++
+	}
+	paschalMoon := FixedFromTime(gregorianDate(year, time.April, 19)) - adjustedEpact
+	return gregorianWeekdayAfter(time.Sunday, paschalMoon)
+}
+
+// Easter returns Easter Sunday of a Gregorian year.
+func (c Computus) Easter(year int) Feast {
+	return newFeast("Easter Sunday", c.easterFixed(year))
+}
+
+type easterOffset struct {
+	name string
+	days int
+}
+
+var westernFeasts = []easterOffset{
+	{"Ash Wednesday", -46},
+	{"Palm Sunday", -7},
+	{"Good Friday", -2},
+	{"Easter Sunday", 0},
+	{"Easter Monday", 1},
+	{"Ascension Day", 39},
+	{"Pentecost", 49},
+	{"Trinity Sunday", 56},
+	{"Corpus Christi", 60},
+}
+
+// The Orthodox Great Lent begins on Clean Monday instead of Ash Wednesday.
+var orthodoxFeasts = []easterOffset{
+	{"Clean Monday", -48},
+	{"Palm Sunday", -7},
+	{"Good Friday", -2},
+	{"Easter Sunday", 0},
+	{"Easter Monday", 1},
+	{"Ascension Day", 39},
+	{"Pentecost", 49},
+}
+
+// MovableFeasts returns the feasts that depend on the date of Easter in
+// a Gregorian year, in chronological order.
+func (c Computus) MovableFeasts(year int) []Feast {
+	offsets := westernFeasts
+	if c == OrthodoxComputus {
+		offsets = orthodoxFeasts
+	}
+
+	easter := c.easterFixed(year)
+	feasts := make([]Feast, len(offsets))
+	for i, offset := range offsets {
+		feasts[i] = newFeast(offset.name, easter+offset.days)
+	}
+	return feasts
+}
+
+// MidsummerEve returns the Nordic Midsummer Eve, the Friday between 19
+// and 25 June.
+func MidsummerEve(year int) Feast {
+	return newFeast("Midsummer Eve", gregorianWeekdayOnOrBefore(time.Friday, FixedFromTime(gregorianDate(year, time.June, 25))))
+}
+
+// MidsummerDay returns the Nordic Midsummer Day, the Saturday between 20
+// and 26 June.
+func MidsummerDay(year int) Feast {
+	return newFeast("Midsummer Day", gregorianWeekdayOnOrBefore(time.Saturday, FixedFromTime(gregorianDate(year, time.June, 26))))
+}
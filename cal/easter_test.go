@@ -0,0 +1,77 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestEaster(t *testing.T) {
+	for i, input := range []struct {
+		computus cal.Computus
+		year     int
+		date     time.Time
+	}{
+		{cal.GregorianComputus, 1818, time.Date(1818, time.March, 22, 0, 0, 0, 0, time.UTC)},
+		{cal.GregorianComputus, 2000, time.Date(2000, time.April, 23, 0, 0, 0, 0, time.UTC)},
+		{cal.GregorianComputus, 2019, time.Date(2019, time.April, 21, 0, 0, 0, 0, time.UTC)},
+		{cal.GregorianComputus, 2024, time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC)},
+		{cal.GregorianComputus, 2038, time.Date(2038, time.April, 25, 0, 0, 0, 0, time.UTC)},
+		{cal.GregorianComputus, 2285, time.Date(2285, time.March, 22, 0, 0, 0, 0, time.UTC)},
+		{cal.OrthodoxComputus, 2010, time.Date(2010, time.April, 4, 0, 0, 0, 0, time.UTC)},
+		{cal.OrthodoxComputus, 2021, time.Date(2021, time.May, 2, 0, 0, 0, 0, time.UTC)},
+		{cal.OrthodoxComputus, 2023, time.Date(2023, time.April, 16, 0, 0, 0, 0, time.UTC)},
+		{cal.OrthodoxComputus, 2024, time.Date(2024, time.May, 5, 0, 0, 0, 0, time.UTC)},
+	} {
+		easter := input.computus.Easter(input.year)
+		if !easter.Gregorian.Equal(input.date) {
+			t.Errorf("%d: Expected %s Easter %s but found %s\n", i, input.computus, input.date, easter.Gregorian)
+		}
+		if !easter.IFC.Equal(cal.DateAt(input.date)) {
+			t.Errorf("%d: Expected IFC date %+v but found %+v\n", i, cal.DateAt(input.date), easter.IFC)
+		}
+	}
+}
+
+func TestMovableFeasts(t *testing.T) {
+	expected := map[string]*cal.IFCDate{
+		"Ash Wednesday": cal.NewIFCDate(2024, cal.February, 17),
+		"Easter Sunday": cal.NewIFCDate(2024, cal.April, 7),
+		"Ascension Day": cal.NewIFCDate(2024, cal.May, 18),
+		"Pentecost":     cal.NewIFCDate(2024, cal.May, 28),
+	}
+
+	feasts := cal.GregorianComputus.MovableFeasts(2024)
+	found := 0
+	for i, feast := range feasts {
+		if i > 0 && !feast.Gregorian.After(feasts[i-1].Gregorian) {
+			t.Errorf("Expected %s after %s\n", feast.Name, feasts[i-1].Name)
+		}
+		if date, ok := expected[feast.Name]; ok {
+			found++
+			if !feast.IFC.Equal(date) {
+				t.Errorf("Expected %s on %+v but found %+v\n", feast.Name, date, feast.IFC)
+			}
+		}
+	}
+	if found != len(expected) {
+		t.Errorf("Expected %d feasts but found %d\n", len(expected), found)
+	}
+}
+
+func TestMidsummer(t *testing.T) {
+	for i, input := range []struct {
+		feast cal.Feast
+		date  time.Time
+	}{
+		{cal.MidsummerEve(2024), time.Date(2024, time.June, 21, 0, 0, 0, 0, time.UTC)},
+		{cal.MidsummerDay(2024), time.Date(2024, time.June, 22, 0, 0, 0, 0, time.UTC)},
+		{cal.MidsummerEve(2025), time.Date(2025, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{cal.MidsummerDay(2026), time.Date(2026, time.June, 20, 0, 0, 0, 0, time.UTC)},
+	} {
+		if !input.feast.Gregorian.Equal(input.date) {
+			t.Errorf("%d: Expected %s on %s but found %s\n", i, input.feast.Name, input.date, input.feast.Gregorian)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package cal
+
+import "fmt"
+
+// An Era selects how years are numbered when they are displayed. Dates are
+// always stored with Common Era years.
+type Era int
+
+const (
+	CommonEra Era = iota
+
+	// HoloceneEra adds 10000 to Common Era years, placing year 1 near the
+	// beginning of the Holocene and all of recorded history in positive
+	// years.
+	HoloceneEra
+)
+
+const holoceneOffset = 10000
+
+func (e Era) Year(commonEraYear int) int {
+	if e == HoloceneEra {
+		return commonEraYear + holoceneOffset
+	}
+	return commonEraYear
+}
+
+func (e Era) CommonEraYear(year int) int {
+	if e == HoloceneEra {
+		return year - holoceneOffset
+	}
+	return year
+}
+
+func (e Era) FormatYear(commonEraYear int) string {
+	if e == HoloceneEra {
+		return fmt.Sprintf("%d HE", e.Year(commonEraYear))
+	}
+	return fmt.Sprintf("%d", commonEraYear)
+}
@@ -0,0 +1,283 @@
+package cal
+
+import (
+	"fmt"
+	"time"
+)
+
+// A FiscalPattern gives the lengths of the periods of a fiscal year.
+type FiscalPattern int
+
+const (
+	// ThirteenPeriods divides a year of 52 or 53 weeks into 13 periods of
+	// four weeks.
+	ThirteenPeriods FiscalPattern = iota
+
+	// The retail patterns divide each quarter of 13 weeks into three
+	// periods of the given number of weeks.
+	Pattern445
+	Pattern454
+	Pattern544
+
+	// IFCPeriods uses the IFC months as periods, as Kodak did. The fiscal
+	// year is the calendar year, and Leap Day and Year Day belong to the
+	// sixth and the last period.
+	IFCPeriods
+)
+
+var fiscalPatternNames = []string{
+	"13 periods",
+	"4-4-5",
+	"4-5-4",
+	"5-4-4",
+	"IFC",
+}
+
+func (p FiscalPattern) String() string {
+	if p >= 0 && int(p) < len(fiscalPatternNames) {
+		return fiscalPatternNames[p]
+	}
+	return fmt.Sprintf("%%!FiscalPattern(%d)", int(p))
+}
+
+func (p FiscalPattern) periodWeeks() []int {
+	switch p {
+	case Pattern445:
+		return []int{4, 4, 5, 4, 4, 5, 4, 4, 5, 4, 4, 5}
+	case Pattern454:
+		return []int{4, 5, 4, 4, 5, 4, 4, 5, 4, 4, 5, 4}
+	case Pattern544:
+		return []int{5, 4, 4, 5, 4, 4, 5, 4, 4, 5, 4, 4}
+	}
+	return []int{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4}
+}
+
+func (p FiscalPattern) PeriodsInYear() int {
+	return len(p.periodWeeks())
+}
+
+// quarterOfPeriod splits 13 periods into quarters of 3, 3, 3 and 4 periods.
+func (p FiscalPattern) quarterOfPeriod(period int) int {
+	if quarter := (period-1)/3 + 1; quarter <= 4 {
+		return quarter
+	}
+	return 4
+}
+
+// A FiscalAnchor selects the first day of a fiscal year relative to the
+// configured start date.
+type FiscalAnchor int
+
+const (
+	// NearestWeekday starts the year on the start weekday nearest to the
+	// start date, like the NRF retail calendar.
+	NearestWeekday FiscalAnchor = iota
+	LastWeekdayOnOrBefore
+	FirstWeekdayOnOrAfter
+)
+
+// FiscalCalendar describes a fiscal year made of whole weeks. Fiscal years
+// are named after the Gregorian year of their anchor, StartMonth and
+// StartDay, so a year anchored on 1 January may start in the previous
+// December. A year has 52 weeks, or 53 when the drift of the start weekday
+// requires it; the extra week is added to the last period.
+type FiscalCalendar struct {
+	Pattern      FiscalPattern
+	StartMonth   time.Month
+	StartDay     int
+	StartWeekday time.Weekday
+	Anchor       FiscalAnchor
+}
+
+// NRFCalendar is the 4-5-4 retail calendar of the National Retail
+// Federation: the year starts on the Sunday nearest to 1 February.
+var NRFCalendar = &FiscalCalendar{
+	Pattern:      Pattern454,
+	StartMonth:   time.February,
+	StartDay:     1,
+	StartWeekday: time.Sunday,
+	Anchor:       NearestWeekday,
+}
+
+// KodakCalendar runs the books on IFC months.
+var KodakCalendar = &FiscalCalendar{
+	Pattern:    IFCPeriods,
+	StartMonth: time.January,
+	StartDay:   1,
+}
+
+type FiscalPeriod struct {
+	Year    int
+	Quarter int
+	Period  int
+	Weeks   int
+	Start   time.Time
+	End     time.Time
+}
+
+func (p *FiscalPeriod) StartDate() *IFCDate {
+	return DateAt(p.Start)
+}
+
+func (p *FiscalPeriod) EndDate() *IFCDate {
+	return DateAt(p.End)
+}
+
+func (p *FiscalPeriod) Contains(t time.Time) bool {
+	fixed := FixedFromTime(t)
+	return fixed >= FixedFromTime(p.Start) && fixed <= FixedFromTime(p.End)
+}
+
+type FiscalDate struct {
+	Year    int
+	Quarter int
+	Period  int
+	Week    int // Week of the fiscal year, starting from 1.
+	Day     int // Day of the period, starting from 1.
+}
+
+func (c *FiscalCalendar) yearStartFixed(year int) int {
+	if c.Pattern == IFCPeriods {
+		return FixedFromTime(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC))
+	}
+
+	anchor := time.Date(year, c.StartMonth, c.StartDay, 0, 0, 0, 0, time.UTC)
+	daysAfter := mod(int(c.StartWeekday)-int(anchor.Weekday()), DaysInWeek)
+	var offset int
+	switch c.Anchor {
+	case FirstWeekdayOnOrAfter:
+		offset = daysAfter
+	case LastWeekdayOnOrBefore:
+		offset = daysAfter
+		if offset > 0 {
+			offset -= DaysInWeek
+		}
+	default:
+		offset = daysAfter
+		if offset > 3 {
+			offset -= DaysInWeek
+		}
+	}
+	return FixedFromTime(anchor) + offset
+}
+
+func (c *FiscalCalendar) YearStart(year int) time.Time {
+	return TimeFromFixed(c.yearStartFixed(year))
+}
+
+func (c *FiscalCalendar) YearEnd(year int) time.Time {
+	return TimeFromFixed(c.yearStartFixed(year+1) - 1)
+}
+
+// WeeksInYear returns 52 or 53. For IFC periods the intercalary days are
+// not counted as weeks.
+func (c *FiscalCalendar) WeeksInYear(year int) int {
+	if c.Pattern == IFCPeriods {
+		return MonthsInYear * WeeksInMonth
+	}
+	return (c.yearStartFixed(year+1) - c.yearStartFixed(year)) / DaysInWeek
+}
+
+func (c *FiscalCalendar) Periods(year int) []*FiscalPeriod {
+	if c.Pattern == IFCPeriods {
+		periods := make([]*FiscalPeriod, MonthsInYear)
+		for month := January; month <= December; month++ {
+			start := NewIFCDate(year, month, 1)
+			periods[month-1] = &FiscalPeriod{
+				Year:    year,
+				Quarter: c.Pattern.quarterOfPeriod(int(month)),
+				Period:  int(month),
+				Weeks:   WeeksInMonth,
+				Start:   start.ToUTCTime(),
+				End:     NewIFCDate(year, month, DaysInMonth(year, month)).ToUTCTime(),
+			}
+		}
+		return periods
+	}
+
+	weeks := c.Pattern.periodWeeks()
+	periods := make([]*FiscalPeriod, len(weeks))
+	start := c.yearStartFixed(year)
+	for i, periodWeeks := range weeks {
+		if i == len(weeks)-1 && c.WeeksInYear(year) == 53 {
+			periodWeeks++
+		}
+		end := start + periodWeeks*DaysInWeek - 1
+		periods[i] = &FiscalPeriod{
+			Year:    year,
+			Quarter: c.Pattern.quarterOfPeriod(i + 1),
+			Period:  i + 1,
+			Weeks:   periodWeeks,
+			Start:   TimeFromFixed(start),
+			End:     TimeFromFixed(end),
+		}
+		start = end + 1
+	}
+	return periods
+}
+
+func (c *FiscalCalendar) Period(year int, period int) *FiscalPeriod {
+	periods := c.Periods(year)
+	if period < 1 || period > len(periods) {
+		return nil
+	}
+	return periods[period-1]
+}
+
+// Quarter returns the first and last day of a fiscal quarter.
+func (c *FiscalCalendar) Quarter(year int, quarter int) (start time.Time, end time.Time) {
+	for _, period := range c.Periods(year) {
+		if period.Quarter != quarter {
+			continue
+		}
+		if start.IsZero() {
+			start = period.Start
+		}
+		end = period.End
+	}
+	return
+}
+
+func (c *FiscalCalendar) YearOf(t time.Time) int {
+	fixed := FixedFromTime(t)
+	year := t.Year()
+	if fixed < c.yearStartFixed(year) {
+		return year - 1
+	}
+	if fixed >= c.yearStartFixed(year+1) {
+		return year + 1
+	}
+	return year
+}
+
+func (c *FiscalCalendar) DateAt(t time.Time) *FiscalDate {
+	year := c.YearOf(t)
+	fixed := FixedFromTime(t)
+
+	for _, period := range c.Periods(year) {
+		if !period.Contains(t) {
+			continue
+		}
+
+		day := fixed - FixedFromTime(period.Start) + 1
+		var week int
+		if c.Pattern == IFCPeriods {
+			date := DateAt(t)
+			week = (int(date.Month)-1)*WeeksInMonth + (date.Day-1)/DaysInWeek + 1
+			if date.Day > daysInMonth {
+				week--
+			}
+		} else {
+			week = (fixed-c.yearStartFixed(year))/DaysInWeek + 1
+		}
+
+		return &FiscalDate{
+			Year:    year,
+			Quarter: period.Quarter,
+			Period:  period.Period,
+			Week:    week,
+			Day:     day,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestFiscalYearBoundaries(t *testing.T) {
+	for i, input := range []struct {
+		calendar *cal.FiscalCalendar
+		year     int
+		start    time.Time
+		end      time.Time
+		weeks    int
+	}{
+		{
+			cal.NRFCalendar,
+			2022,
+			time.Date(2022, time.January, 30, 0, 0, 0, 0, time.UTC),
+			time.Date(2023, time.January, 28, 0, 0, 0, 0, time.UTC),
+			52,
+		},
+		{
+			cal.NRFCalendar,
+			2023,
+			time.Date(2023, time.January, 29, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.February, 3, 0, 0, 0, 0, time.UTC),
+			53,
+		},
+		{
+			&cal.FiscalCalendar{
+				Pattern:      cal.ThirteenPeriods,
+				StartMonth:   time.October,
+				StartDay:     1,
+				StartWeekday: time.Monday,
+				Anchor:       cal.LastWeekdayOnOrBefore,
+			},
+			2022,
+			time.Date(2022, time.September, 26, 0, 0, 0, 0, time.UTC),
+			time.Date(2023, time.September, 24, 0, 0, 0, 0, time.UTC),
+			52,
+		},
+		{
+			cal.KodakCalendar,
+			2020,
+			time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, time.December, 31, 0, 0, 0, 0, time.UTC),
+			52,
+		},
+	} {
+		start := input.calendar.YearStart(input.year)
+		if !start.Equal(input.start) {
+			t.Errorf("%d: Expected start %+v but found %+v\n", i, input.start, start)
+		}
+		end := input.calendar.YearEnd(input.year)
+		if !end.Equal(input.end) {
+			t.Errorf("%d: Expected end %+v but found %+v\n", i, input.end, end)
+		}
+		weeks := input.calendar.WeeksInYear(input.year)
+		if weeks != input.weeks {
+			t.Errorf("%d: Expected %d weeks but found %d\n", i, input.weeks, weeks)
+		}
+
+		periods := input.calendar.Periods(input.year)
+		lastPeriod := periods[len(periods)-1]
+		if !lastPeriod.End.Equal(input.end) {
+			t.Errorf("%d: Expected last period to end on %+v but found %+v\n", i, input.end, lastPeriod.End)
+		}
+	}
+}
+
+func TestFiscalDateLookup(t *testing.T) {
+	for i, input := range []struct {
+		calendar *cal.FiscalCalendar
+		time     time.Time
+		result   cal.FiscalDate
+	}{
+		{
+			cal.NRFCalendar,
+			time.Date(2023, time.January, 29, 0, 0, 0, 0, time.UTC),
+			cal.FiscalDate{Year: 2023, Quarter: 1, Period: 1, Week: 1, Day: 1},
+		},
+		{
+			cal.NRFCalendar,
+			time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+			cal.FiscalDate{Year: 2023, Quarter: 1, Period: 2, Week: 5, Day: 4},
+		},
+		{
+			cal.NRFCalendar,
+			time.Date(2024, time.February, 3, 0, 0, 0, 0, time.UTC),
+			cal.FiscalDate{Year: 2023, Quarter: 4, Period: 12, Week: 53, Day: 35},
+		},
+		{
+			cal.KodakCalendar,
+			time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC),
+			cal.FiscalDate{Year: 2021, Quarter: 4, Period: 13, Week: 52, Day: 29},
+		},
+		{
+			cal.KodakCalendar,
+			time.Date(2021, time.October, 1, 0, 0, 0, 0, time.UTC),
+			cal.FiscalDate{Year: 2021, Quarter: 4, Period: 10, Week: 40, Day: 22},
+		},
+	} {
+		date := input.calendar.DateAt(input.time)
+		if *date != input.result {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.result, *date)
+		}
+	}
+}
+
+func TestFiscalQuarters(t *testing.T) {
+	calendar := &cal.FiscalCalendar{
+		Pattern:      cal.ThirteenPeriods,
+		StartMonth:   time.January,
+		StartDay:     1,
+		StartWeekday: time.Sunday,
+	}
+	start, end := calendar.Quarter(2023, 4)
+	expectedStart := time.Date(2023, time.September, 10, 0, 0, 0, 0, time.UTC)
+	expectedEnd := time.Date(2023, time.December, 30, 0, 0, 0, 0, time.UTC)
+	if !start.Equal(expectedStart) || !end.Equal(expectedEnd) {
+		t.Errorf("Expected %+v-%+v but found %+v-%+v\n", expectedStart, expectedEnd, start, end)
+	}
+}
+
+func TestFiscalYearNamedAfterAnchor(t *testing.T) {
+	calendar := &cal.FiscalCalendar{
+		Pattern:      cal.ThirteenPeriods,
+		StartMonth:   time.January,
+		StartDay:     1,
+		StartWeekday: time.Sunday,
+	}
+	expectedStart := time.Date(2024, time.December, 29, 0, 0, 0, 0, time.UTC)
+	if start := calendar.YearStart(2025); !start.Equal(expectedStart) {
+		t.Errorf("Expected %v but found %v\n", expectedStart, start)
+	}
+	if year := calendar.YearOf(expectedStart); year != 2025 {
+		t.Errorf("Expected fiscal year 2025 but found %d\n", year)
+	}
+}
@@ -0,0 +1,42 @@
+package cal
+
+import "time"
+
+// Fixed day numbers count days from 1 January 1 CE in the proleptic
+// Gregorian calendar, which is day 1. They are used as a common ground
+// when converting between calendars.
+const unixEpochFixed = 719163
+
+const secondsInDay = 24 * 60 * 60
+
+func FixedFromTime(t time.Time) int {
+	y, m, d := t.Date()
+	midnight := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	return int(midnight.Unix()/secondsInDay) + unixEpochFixed
+}
+
+func TimeFromFixed(fixed int) time.Time {
+	return time.Unix(int64(fixed-unixEpochFixed)*secondsInDay, 0).UTC()
+}
+
+func (d *IFCDate) Fixed() int {
+	return FixedFromTime(d.ToUTCTime())
+}
+
+func DateFromFixed(fixed int) *IFCDate {
+	return DateAt(TimeFromFixed(fixed))
+}
+
+// floorDiv and mod round towards negative infinity, as required by the
+// calendrical formulas for dates before their epochs.
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+func mod(a, b int) int {
+	return a - b*floorDiv(a, b)
+}
@@ -0,0 +1,148 @@
+package cal
+
+import (
+	"fmt"
+	"time"
+)
+
+// The French Republican calendar has twelve months of 30 days followed by
+// five complementary days, or six in leap years. Like the IFC, it keeps
+// every month the same length and places the extra days outside of them.
+// Leap years follow the arithmetic rule proposed by Romme: every fourth
+// year except most century years and every 4000th year. The historical
+// calendar placed the leap days differently during its brief use.
+const frenchRepublicanEpoch = 654415 // 22 September 1792
+
+type FrenchRepublicanMonth int
+
+const (
+	Vendemiaire FrenchRepublicanMonth = 1 + iota
+	Brumaire
+	Frimaire
+	Nivose
+	Pluviose
+	Ventose
+	Germinal
+	Floreal
+	Prairial
+	Messidor
+	Thermidor
+	Fructidor
+
+	// The complementary days are treated as a short thirteenth month.
+	Sansculottides
+)
+
+var frenchRepublicanMonthNames = []string{
+	"Vendémiaire",
+	"Brumaire",
+	"Frimaire",
+	"Nivôse",
+	"Pluviôse",
+	"Ventôse",
+	"Germinal",
+	"Floréal",
+	"Prairial",
+	"Messidor",
+	"Thermidor",
+	"Fructidor",
+	"Sansculottides",
+}
+
+var complementaryDayNames = []string{
+	"Fête de la Vertu",
+	"Fête du Génie",
+	"Fête du Travail",
+	"Fête de l'Opinion",
+	"Fête des Récompenses",
+	"Fête de la Révolution",
+}
+
+func (m FrenchRepublicanMonth) String() string {
+	if m > 0 && m <= MonthsInYear {
+		return frenchRepublicanMonthNames[int(m)-1]
+	}
+	return fmt.Sprintf("%%!FrenchRepublicanMonth(%d)", int(m))
+}
+
+type FrenchRepublicanDate struct {
+	Year  int
+	Month FrenchRepublicanMonth
+	Day   int
+}
+
+func NewFrenchRepublicanDate(year int, month FrenchRepublicanMonth, day int) *FrenchRepublicanDate {
+	return &FrenchRepublicanDate{Year: year, Month: month, Day: day}
+}
+
+func FrenchRepublicanDateAt(t time.Time) *FrenchRepublicanDate {
+	return FrenchRepublicanDateFromFixed(FixedFromTime(t))
+}
+
+func FrenchRepublicanDateFromFixed(fixed int) *FrenchRepublicanDate {
+	year := floorDiv(4000*(fixed-frenchRepublicanEpoch+2), 1460969) + 1
+	if fixed < fixedFromFrenchRepublican(year, Vendemiaire, 1) {
+		year--
+	}
+
+	month := FrenchRepublicanMonth((fixed-fixedFromFrenchRepublican(year, Vendemiaire, 1))/30 + 1)
+	day := fixed - fixedFromFrenchRepublican(year, month, 1) + 1
+	return NewFrenchRepublicanDate(year, month, day)
+}
+
+func fixedFromFrenchRepublican(year int, month FrenchRepublicanMonth, day int) int {
+	return frenchRepublicanEpoch - 1 + 365*(year-1) +
+		floorDiv(year-1, 4) - floorDiv(year-1, 100) + floorDiv(year-1, 400) - floorDiv(year-1, 4000) +
+		30*(int(month)-1) + day
+}
+
+func (d *FrenchRepublicanDate) Fixed() int {
+	return fixedFromFrenchRepublican(d.Year, d.Month, d.Day)
+}
+
+func (d *FrenchRepublicanDate) ToUTCTime() time.Time {
+	return TimeFromFixed(d.Fixed())
+}
+
+func (d *FrenchRepublicanDate) ToIFCDate() *IFCDate {
+	return DateFromFixed(d.Fixed())
+}
+
+func (d *FrenchRepublicanDate) Equal(other *FrenchRepublicanDate) bool {
+	return d.Day == other.Day && d.Month == other.Month && d.Year == other.Year
+}
+
+func (d *FrenchRepublicanDate) IsComplementaryDay() bool {
+	return d.Month == Sansculottides
+}
+
+// ComplementaryDayName returns the name of the festival celebrated on a
+// complementary day, or an empty string for days within the months.
+func (d *FrenchRepublicanDate) ComplementaryDayName() string {
+	if d.IsComplementaryDay() && d.Day > 0 && d.Day <= len(complementaryDayNames) {
+		return complementaryDayNames[d.Day-1]
+	}
+	return ""
+}
+
+func IsFrenchRepublicanLeapYear(year int) bool {
+	switch {
+	case mod(year, 4) != 0:
+		return false
+	case mod(year, 400) == 100 || mod(year, 400) == 200 || mod(year, 400) == 300:
+		return false
+	case mod(year, 4000) == 0:
+		return false
+	}
+	return true
+}
+
+func DaysInFrenchRepublicanMonth(year int, month FrenchRepublicanMonth) int {
+	if month < Sansculottides {
+		return 30
+	}
+	if IsFrenchRepublicanLeapYear(year) {
+		return 6
+	}
+	return 5
+}
@@ -0,0 +1,57 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestFrenchRepublicanDateConversion(t *testing.T) {
+	for i, input := range []struct {
+		frenchDate *cal.FrenchRepublicanDate
+		time       time.Time
+	}{
+		{
+			cal.NewFrenchRepublicanDate(1, cal.Vendemiaire, 1),
+			time.Date(1792, time.September, 22, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewFrenchRepublicanDate(2, cal.Thermidor, 9),
+			time.Date(1794, time.July, 27, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewFrenchRepublicanDate(4, cal.Sansculottides, 6),
+			time.Date(1796, time.September, 21, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewFrenchRepublicanDate(231, cal.Sansculottides, 5),
+			time.Date(2023, time.September, 21, 0, 0, 0, 0, time.UTC),
+		},
+	} {
+		date := cal.FrenchRepublicanDateAt(input.time)
+		if !date.Equal(input.frenchDate) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.frenchDate, date)
+		}
+
+		timeStamp := input.frenchDate.ToUTCTime()
+		if !timeStamp.Equal(input.time) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.time, timeStamp)
+		}
+	}
+}
+
+func TestComplementaryDayNames(t *testing.T) {
+	for i, input := range []struct {
+		date *cal.FrenchRepublicanDate
+		name string
+	}{
+		{cal.NewFrenchRepublicanDate(4, cal.Sansculottides, 6), "Fête de la Révolution"},
+		{cal.NewFrenchRepublicanDate(5, cal.Sansculottides, 1), "Fête de la Vertu"},
+		{cal.NewFrenchRepublicanDate(5, cal.Fructidor, 1), ""},
+	} {
+		if name := input.date.ComplementaryDayName(); name != input.name {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, input.name, name)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package cal
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// The Hebrew calendar is lunisolar: seven years in each 19-year cycle have
+// a thirteenth month, and the year is lengthened or shortened by a day
+// to keep Rosh Hashanah off certain weekdays. Months are numbered from
+// Nisan, but the year number changes on 1 Tishrei.
+const (
+	hebrewEpoch    = -1373427 // 7 October 3761 BCE (Julian)
+	hebrewMeanYear = 35975351.0 / 98496.0
+	partsInDay     = 25920
+)
+
+type HebrewMonth int
+
+const (
+	Nisan HebrewMonth = 1 + iota
+	Iyyar
+	Sivan
+	Tammuz
+	Av
+	Elul
+	Tishrei
+	Marheshvan
+	Kislev
+	Tevet
+	Shevat
+	Adar
+	AdarII
+)
+
+var hebrewMonthNames = []string{
+	"Nisan",
+	"Iyyar",
+	"Sivan",
+	"Tammuz",
+	"Av",
+	"Elul",
+	"Tishrei",
+	"Marheshvan",
+	"Kislev",
+	"Tevet",
+	"Shevat",
+	"Adar",
+	"Adar II",
+}
+
+func (m HebrewMonth) String() string {
+	if m >= Nisan && m <= AdarII {
+		return hebrewMonthNames[int(m)-1]
+	}
+	return fmt.Sprintf("%%!HebrewMonth(%d)", int(m))
+}
+
+type HebrewDate struct {
+	Year  int
+	Month HebrewMonth
+	Day   int
+}
+
+func NewHebrewDate(year int, month HebrewMonth, day int) *HebrewDate {
+	return &HebrewDate{Year: year, Month: month, Day: day}
+}
+
+func HebrewDateAt(t time.Time) *HebrewDate {
+	return HebrewDateFromFixed(FixedFromTime(t))
+}
+
+func HebrewDateFromFixed(fixed int) *HebrewDate {
+	year := int(math.Floor(float64(fixed-hebrewEpoch)/hebrewMeanYear)) - 1
+	for hebrewNewYear(year+1) <= fixed {
+		year++
+	}
+
+	month := Tishrei
+	if fixed >= fixedFromHebrew(year, Nisan, 1) {
+		month = Nisan
+	}
+	for fixed > fixedFromHebrew(year, month, DaysInHebrewMonth(year, month)) {
+		month = nextHebrewMonth(year, month)
+	}
+
+	day := fixed - fixedFromHebrew(year, month, 1) + 1
+	return NewHebrewDate(year, month, day)
+}
+
+func fixedFromHebrew(year int, month HebrewMonth, day int) int {
+	fixed := hebrewNewYear(year) + day - 1
+	if month < Tishrei {
+		for m := Tishrei; m <= lastHebrewMonth(year); m++ {
+			fixed += DaysInHebrewMonth(year, m)
+		}
+		for m := Nisan; m < month; m++ {
+			fixed += DaysInHebrewMonth(year, m)
+		}
+	} else {
+		for m := Tishrei; m < month; m++ {
+			fixed += DaysInHebrewMonth(year, m)
+		}
+	}
+	return fixed
+}
+
+func nextHebrewMonth(year int, month HebrewMonth) HebrewMonth {
+	if month == lastHebrewMonth(year) {
+		return Nisan
+	}
+	return month + 1
+}
+
+func lastHebrewMonth(year int) HebrewMonth {
+	if IsHebrewLeapYear(year) {
+		return AdarII
+	}
+	return Adar
+}
+
+func hebrewElapsedDays(year int) int {
+	monthsElapsed := floorDiv(235*year-234, 19)
+	partsElapsed := 12084 + 13753*monthsElapsed
+	days := 29*monthsElapsed + floorDiv(partsElapsed, partsInDay)
+	if mod(3*(days+1), 7) < 3 {
+		return days + 1
+	}
+	return days
+}
+
+func hebrewYearLengthCorrection(year int) int {
+	ny0 := hebrewElapsedDays(year - 1)
+	ny1 := hebrewElapsedDays(year)
+	ny2 := hebrewElapsedDays(year + 1)
+	if ny2-ny1 == 356 {
+		return 2
+	}
+	if ny1-ny0 == 382 {
+		return 1
+	}
+	return 0
+}
+
+func hebrewNewYear(year int) int {
+	return hebrewEpoch + hebrewElapsedDays(year) + hebrewYearLengthCorrection(year)
+}
+
+func (d *HebrewDate) Fixed() int {
+	return fixedFromHebrew(d.Year, d.Month, d.Day)
+}
+
+func (d *HebrewDate) ToUTCTime() time.Time {
+	return TimeFromFixed(d.Fixed())
+}
+
+func (d *HebrewDate) ToIFCDate() *IFCDate {
+	return DateFromFixed(d.Fixed())
+}
+
+func (d *HebrewDate) Equal(other *HebrewDate) bool {
+	return d.Day == other.Day && d.Month == other.Month && d.Year == other.Year
+}
+
+// MonthName is like Month.String, but calls the twelfth month Adar I in
+// leap years.
+func (d *HebrewDate) MonthName() string {
+	if d.Month == Adar && IsHebrewLeapYear(d.Year) {
+		return "Adar I"
+	}
+	return d.Month.String()
+}
+
+func IsHebrewLeapYear(year int) bool {
+	return mod(7*year+1, 19) < 7
+}
+
+// MonthsInHebrewYear returns 13 for leap years, which have Adar II, and 12
+// for the others.
+func MonthsInHebrewYear(year int) int {
+	return int(lastHebrewMonth(year))
+}
+
+func DaysInHebrewYear(year int) int {
+	return hebrewNewYear(year+1) - hebrewNewYear(year)
+}
+
+func DaysInHebrewMonth(year int, month HebrewMonth) int {
+	switch month {
+	case Iyyar, Tammuz, Elul, Tevet, AdarII:
+		return 29
+	case Adar:
+		if !IsHebrewLeapYear(year) {
+			return 29
+		}
+	case Marheshvan:
+		if daysInYear := DaysInHebrewYear(year); daysInYear != 355 && daysInYear != 385 {
+			return 29
+		}
+	case Kislev:
+		if daysInYear := DaysInHebrewYear(year); daysInYear == 353 || daysInYear == 383 {
+			return 29
+		}
+	}
+	return 30
+}
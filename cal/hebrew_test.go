@@ -0,0 +1,85 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestHebrewDateConversion(t *testing.T) {
+	for i, input := range []struct {
+		hebrewDate *cal.HebrewDate
+		time       time.Time
+	}{
+		{
+			cal.NewHebrewDate(5783, cal.Tishrei, 1),
+			time.Date(2022, time.September, 26, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewHebrewDate(5782, cal.Elul, 29),
+			time.Date(2022, time.September, 25, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewHebrewDate(5782, cal.Nisan, 15),
+			time.Date(2022, time.April, 16, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewHebrewDate(5783, cal.Kislev, 25),
+			time.Date(2022, time.December, 19, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewHebrewDate(5784, cal.AdarII, 1),
+			time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC),
+		},
+	} {
+		date := cal.HebrewDateAt(input.time)
+		if !date.Equal(input.hebrewDate) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.hebrewDate, date)
+		}
+
+		timeStamp := input.hebrewDate.ToUTCTime()
+		if !timeStamp.Equal(input.time) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.time, timeStamp)
+		}
+	}
+}
+
+func TestHebrewYearLengths(t *testing.T) {
+	for i, input := range []struct {
+		year   int
+		days   int
+		months int
+	}{
+		{5780, 355, 12},
+		{5781, 353, 12},
+		{5782, 384, 13},
+		{5783, 355, 12},
+		{5784, 383, 13},
+	} {
+		days := cal.DaysInHebrewYear(input.year)
+		if days != input.days {
+			t.Errorf("%d: Expected %d days but found %d\n", i, input.days, days)
+		}
+		months := cal.MonthsInHebrewYear(input.year)
+		if months != input.months {
+			t.Errorf("%d: Expected %d months but found %d\n", i, input.months, months)
+		}
+	}
+}
+
+func TestHebrewMonthName(t *testing.T) {
+	for i, input := range []struct {
+		date *cal.HebrewDate
+		name string
+	}{
+		{cal.NewHebrewDate(5783, cal.Adar, 1), "Adar"},
+		{cal.NewHebrewDate(5784, cal.Adar, 1), "Adar I"},
+		{cal.NewHebrewDate(5784, cal.AdarII, 1), "Adar II"},
+	} {
+		name := input.date.MonthName()
+		if name != input.name {
+			t.Errorf("%d: Expected %s but found %s\n", i, input.name, name)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package cal
+
+import (
+	"sort"
+	"time"
+)
+
+// A Holiday is a named observance on an IFC date.
+type Holiday struct {
+	Name string
+	Date *IFCDate
+}
+
+// A HolidayProvider returns the observances of an IFC year, which is also
+// the Gregorian year with the same number, sorted by date.
+type HolidayProvider interface {
+	Holidays(year int) []Holiday
+}
+
+// HolidaysOn returns the observances of a provider on the given date.
+func HolidaysOn(provider HolidayProvider, date *IFCDate) []Holiday {
+	var holidays []Holiday
+	for _, holiday := range provider.Holidays(date.Year) {
+		if holiday.Date.Equal(date) {
+			holidays = append(holidays, holiday)
+		}
+	}
+	return holidays
+}
+
+type holidayRule struct {
+	name string
+	date func(year int) (time.Time, bool)
+}
+
+// A holidaySet computes holidays from Gregorian rules and maps them into
+// IFC dates. Rules are evaluated for the neighbouring years as well, since
+// a holiday observed on another day can move to an adjacent year.
+type holidaySet []holidayRule
+
+func (s holidaySet) Holidays(year int) []Holiday {
+	var holidays []Holiday
+	for y := year - 1; y <= year+1; y++ {
+		for _, rule := range s {
+			t, ok := rule.date(y)
+			if !ok || t.Year() != year {
+				continue
+			}
+			holidays = append(holidays, Holiday{Name: rule.name, Date: DateAt(t)})
+		}
+	}
+
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Date.dayOfYear < holidays[j].Date.dayOfYear
+	})
+	return holidays
+}
+
+type multiHolidayProvider []HolidayProvider
+
+func (p multiHolidayProvider) Holidays(year int) []Holiday {
+	var holidays []Holiday
+	for _, provider := range p {
+		holidays = append(holidays, provider.Holidays(year)...)
+	}
+
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Date.dayOfYear < holidays[j].Date.dayOfYear
+	})
+	return holidays
+}
+
+// CombineHolidays merges the observances of several providers.
+func CombineHolidays(providers ...HolidayProvider) HolidayProvider {
+	return multiHolidayProvider(providers)
+}
+
+func gregorianDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func onDate(month time.Month, day int) func(int) (time.Time, bool) {
+	return func(year int) (time.Time, bool) {
+		return gregorianDate(year, month, day), true
+	}
+}
+
+// onNthWeekday returns the nth weekday of a Gregorian month, counting
+// from the end of the month for negative n.
+func onNthWeekday(month time.Month, weekday time.Weekday, n int) func(int) (time.Time, bool) {
+	return func(year int) (time.Time, bool) {
+		if n < 0 {
+			last := gregorianDate(year, month+1, 0)
+			offset := mod(int(last.Weekday())-int(weekday), DaysInWeek)
+			return last.AddDate(0, 0, -offset+(n+1)*DaysInWeek), true
+		}
+		first := gregorianDate(year, month, 1)
+		offset := mod(int(weekday)-int(first.Weekday()), DaysInWeek)
+		return first.AddDate(0, 0, offset+(n-1)*DaysInWeek), true
+	}
+}
+
+// onWeekdayBetween returns the weekday falling within the week starting
+// on the given Gregorian date.
+func onWeekdayBetween(month time.Month, firstDay int, weekday time.Weekday) func(int) (time.Time, bool) {
+	return func(year int) (time.Time, bool) {
+		first := gregorianDate(year, month, firstDay)
+		return first.AddDate(0, 0, mod(int(weekday)-int(first.Weekday()), DaysInWeek)), true
+	}
+}
+
+func fromEaster(days int) func(int) (time.Time, bool) {
+	return func(year int) (time.Time, bool) {
+		return GregorianComputus.Easter(year).Gregorian.AddDate(0, 0, days), true
+	}
+}
+
+func onFeast(feast func(int) Feast) func(int) (time.Time, bool) {
+	return func(year int) (time.Time, bool) {
+		return feast(year).Gregorian, true
+	}
+}
+
+func since(firstYear int, rule func(int) (time.Time, bool)) func(int) (time.Time, bool) {
+	return func(year int) (time.Time, bool) {
+		if year < firstYear {
+			return time.Time{}, false
+		}
+		return rule(year)
+	}
+}
+
+// observedOnNearestWeekday moves holidays falling on Saturday to Friday
+// and on Sunday to Monday, as US federal holidays are observed.
+func observedOnNearestWeekday(rule func(int) (time.Time, bool)) func(int) (time.Time, bool) {
+	return func(year int) (time.Time, bool) {
+		t, ok := rule(year)
+		switch t.Weekday() {
+		case time.Saturday:
+			return t.AddDate(0, 0, -1), ok
+		case time.Sunday:
+			return t.AddDate(0, 0, 1), ok
+		}
+		return t, ok
+	}
+}
+
+// substitutedOnMonday moves holidays falling on a weekend to the
+// following Monday, or Tuesday if the Monday is a holiday already.
+func substitutedOnMonday(rule func(int) (time.Time, bool), mondayTaken func(int) bool) func(int) (time.Time, bool) {
+	return func(year int) (time.Time, bool) {
+		t, ok := rule(year)
+		switch t.Weekday() {
+		case time.Saturday:
+			t = t.AddDate(0, 0, 2)
+		case time.Sunday:
+			t = t.AddDate(0, 0, 1)
+		default:
+			return t, ok
+		}
+		if mondayTaken != nil && mondayTaken(year) {
+			t = t.AddDate(0, 0, 1)
+		}
+		return t, ok
+	}
+}
+
+var FinnishHolidays HolidayProvider = holidaySet{
+	{"New Year's Day", onDate(time.January, 1)},
+	{"Epiphany", onDate(time.January, 6)},
+	{"Good Friday", fromEaster(-2)},
+	{"Easter Sunday", fromEaster(0)},
+	{"Easter Monday", fromEaster(1)},
+	{"May Day", onDate(time.May, 1)},
+	{"Ascension Day", fromEaster(39)},
+	{"Whitsunday", fromEaster(49)},
+	{"Midsummer Eve", onFeast(MidsummerEve)},
+	{"Midsummer Day", onFeast(MidsummerDay)},
+	{"All Saints' Day", onWeekdayBetween(time.October, 31, time.Saturday)},
+	{"Independence Day", onDate(time.December, 6)},
+	{"Christmas Eve", onDate(time.December, 24)},
+	{"Christmas Day", onDate(time.December, 25)},
+	{"St. Stephen's Day", onDate(time.December, 26)},
+}
+
+var USFederalHolidays HolidayProvider = holidaySet{
+	{"New Year's Day", observedOnNearestWeekday(onDate(time.January, 1))},
+	{"Martin Luther King Jr. Day", since(1986, onNthWeekday(time.January, time.Monday, 3))},
+	{"Washington's Birthday", onNthWeekday(time.February, time.Monday, 3)},
+	{"Memorial Day", onNthWeekday(time.May, time.Monday, -1)},
+	{"Juneteenth", since(2021, observedOnNearestWeekday(onDate(time.June, 19)))},
+	{"Independence Day", observedOnNearestWeekday(onDate(time.July, 4))},
+	{"Labor Day", onNthWeekday(time.September, time.Monday, 1)},
+	{"Columbus Day", onNthWeekday(time.October, time.Monday, 2)},
+	{"Veterans Day", observedOnNearestWeekday(onDate(time.November, 11))},
+	{"Thanksgiving Day", onNthWeekday(time.November, time.Thursday, 4)},
+	{"Christmas Day", observedOnNearestWeekday(onDate(time.December, 25))},
+}
+
+func christmasOnSaturday(year int) bool {
+	return gregorianDate(year, time.December, 25).Weekday() == time.Saturday
+}
+
+func christmasOnSunday(year int) bool {
+	return gregorianDate(year, time.December, 25).Weekday() == time.Sunday
+}
+
+// UKBankHolidays are the bank holidays of England and Wales. One-off
+// holidays proclaimed for royal events are not included.
+var UKBankHolidays HolidayProvider = holidaySet{
+	{"New Year's Day", substitutedOnMonday(onDate(time.January, 1), nil)},
+	{"Good Friday", fromEaster(-2)},
+	{"Easter Monday", fromEaster(1)},
+	{"Early May bank holiday", onNthWeekday(time.May, time.Monday, 1)},
+	{"Spring bank holiday", onNthWeekday(time.May, time.Monday, -1)},
+	{"Summer bank holiday", onNthWeekday(time.August, time.Monday, -1)},
+	{"Christmas Day", substitutedOnMonday(onDate(time.December, 25), christmasOnSunday)},
+	{"Boxing Day", substitutedOnMonday(onDate(time.December, 26), christmasOnSaturday)},
+}
+
+type ifcObservances struct{}
+
+func (ifcObservances) Holidays(year int) []Holiday {
+	holidays := []Holiday{}
+	if IsLeapYear(year) {
+		holidays = append(holidays, Holiday{Name: LeapDay.String(), Date: NewIFCDate(year, June, 29)})
+	}
+	return append(holidays, Holiday{Name: YearDay.String(), Date: NewIFCDate(year, December, 29)})
+}
+
+// IFCObservances are the days outside of the weeks of the IFC.
+var IFCObservances HolidayProvider = ifcObservances{}
@@ -0,0 +1,72 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func findHoliday(provider cal.HolidayProvider, year int, name string) []time.Time {
+	var dates []time.Time
+	for _, holiday := range provider.Holidays(year) {
+		if holiday.Name == name {
+			dates = append(dates, holiday.Date.ToUTCTime())
+		}
+	}
+	return dates
+}
+
+func TestBuiltInHolidays(t *testing.T) {
+	for i, input := range []struct {
+		provider cal.HolidayProvider
+		year     int
+		name     string
+		dates    []time.Time
+	}{
+		{cal.FinnishHolidays, 2024, "Good Friday", []time.Time{time.Date(2024, time.March, 29, 0, 0, 0, 0, time.UTC)}},
+		{cal.FinnishHolidays, 2024, "Ascension Day", []time.Time{time.Date(2024, time.May, 9, 0, 0, 0, 0, time.UTC)}},
+		{cal.FinnishHolidays, 2024, "Midsummer Eve", []time.Time{time.Date(2024, time.June, 21, 0, 0, 0, 0, time.UTC)}},
+		{cal.FinnishHolidays, 2024, "All Saints' Day", []time.Time{time.Date(2024, time.November, 2, 0, 0, 0, 0, time.UTC)}},
+		{cal.USFederalHolidays, 2021, "New Year's Day", []time.Time{
+			time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC),
+		}},
+		{cal.USFederalHolidays, 2022, "New Year's Day", nil},
+		{cal.USFederalHolidays, 2022, "Juneteenth", []time.Time{time.Date(2022, time.June, 20, 0, 0, 0, 0, time.UTC)}},
+		{cal.USFederalHolidays, 2022, "Thanksgiving Day", []time.Time{time.Date(2022, time.November, 24, 0, 0, 0, 0, time.UTC)}},
+		{cal.USFederalHolidays, 2022, "Memorial Day", []time.Time{time.Date(2022, time.May, 30, 0, 0, 0, 0, time.UTC)}},
+		{cal.UKBankHolidays, 2021, "Christmas Day", []time.Time{time.Date(2021, time.December, 27, 0, 0, 0, 0, time.UTC)}},
+		{cal.UKBankHolidays, 2021, "Boxing Day", []time.Time{time.Date(2021, time.December, 28, 0, 0, 0, 0, time.UTC)}},
+		{cal.UKBankHolidays, 2022, "Christmas Day", []time.Time{time.Date(2022, time.December, 27, 0, 0, 0, 0, time.UTC)}},
+		{cal.UKBankHolidays, 2022, "Boxing Day", []time.Time{time.Date(2022, time.December, 26, 0, 0, 0, 0, time.UTC)}},
+		{cal.IFCObservances, 2024, "Leap Day", []time.Time{time.Date(2024, time.June, 17, 0, 0, 0, 0, time.UTC)}},
+		{cal.IFCObservances, 2023, "Leap Day", nil},
+	} {
+		dates := findHoliday(input.provider, input.year, input.name)
+		if len(dates) != len(input.dates) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.dates, dates)
+			continue
+		}
+		for j := range dates {
+			if !dates[j].Equal(input.dates[j]) {
+				t.Errorf("%d: Expected %+v but found %+v\n", i, input.dates[j], dates[j])
+			}
+		}
+	}
+}
+
+func TestHolidaysOn(t *testing.T) {
+	provider := cal.CombineHolidays(cal.FinnishHolidays, cal.IFCObservances)
+	holidays := cal.HolidaysOn(provider, cal.NewIFCDate(2021, cal.December, 29))
+	if len(holidays) != 1 || holidays[0].Name != "Year Day" {
+		t.Errorf("Expected Year Day but found %+v\n", holidays)
+	}
+
+	holidays = provider.Holidays(2021)
+	for i := 1; i < len(holidays); i++ {
+		if holidays[i].Date.ToUTCTime().Before(holidays[i-1].Date.ToUTCTime()) {
+			t.Errorf("Expected holidays in order but found %s before %s\n", holidays[i-1].Name, holidays[i].Name)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package cal
+
+import (
+	"fmt"
+	"time"
+)
+
+// The tabular Islamic calendar approximates the lunar Hijri calendar with
+// months alternating between 30 and 29 days. Eleven years in each 30-year
+// cycle are leap years, in which the last month has 30 days.
+const islamicEpoch = 227015 // 16 July 622 (Julian)
+
+type IslamicMonth int
+
+const (
+	Muharram IslamicMonth = 1 + iota
+	Safar
+	RabiAlAwwal
+	RabiAlThani
+	JumadaAlAwwal
+	JumadaAlThani
+	Rajab
+	Shaban
+	Ramadan
+	Shawwal
+	DhuAlQadah
+	DhuAlHijjah
+)
+
+const MonthsInIslamicYear = 12
+
+var islamicMonthNames = []string{
+	"Muharram",
+	"Safar",
+	"Rabi' al-Awwal",
+	"Rabi' al-Thani",
+	"Jumada al-Awwal",
+	"Jumada al-Thani",
+	"Rajab",
+	"Sha'ban",
+	"Ramadan",
+	"Shawwal",
+	"Dhu al-Qa'dah",
+	"Dhu al-Hijjah",
+}
+
+func (m IslamicMonth) String() string {
+	if m > 0 && m <= MonthsInIslamicYear {
+		return islamicMonthNames[int(m)-1]
+	}
+	return fmt.Sprintf("%%!IslamicMonth(%d)", int(m))
+}
+
+type IslamicDate struct {
+	Year  int
+	Month IslamicMonth
+	Day   int
+}
+
+func NewIslamicDate(year int, month IslamicMonth, day int) *IslamicDate {
+	return &IslamicDate{Year: year, Month: month, Day: day}
+}
+
+func IslamicDateAt(t time.Time) *IslamicDate {
+	return IslamicDateFromFixed(FixedFromTime(t))
+}
+
+func IslamicDateFromFixed(fixed int) *IslamicDate {
+	year := floorDiv(30*(fixed-islamicEpoch)+10646, 10631)
+	priorDays := fixed - fixedFromIslamic(year, Muharram, 1)
+	month := IslamicMonth(floorDiv(11*priorDays+330, 325))
+	day := fixed - fixedFromIslamic(year, month, 1) + 1
+	return NewIslamicDate(year, month, day)
+}
+
+func fixedFromIslamic(year int, month IslamicMonth, day int) int {
+	m := int(month)
+	return islamicEpoch - 1 + (year-1)*354 + floorDiv(3+11*year, 30) +
+		29*(m-1) + floorDiv(6*m-1, 11) + day
+}
+
+func (d *IslamicDate) Fixed() int {
+	return fixedFromIslamic(d.Year, d.Month, d.Day)
+}
+
+func (d *IslamicDate) ToUTCTime() time.Time {
+	return TimeFromFixed(d.Fixed())
+}
+
+func (d *IslamicDate) ToIFCDate() *IFCDate {
+	return DateFromFixed(d.Fixed())
+}
+
+func (d *IslamicDate) Equal(other *IslamicDate) bool {
+	return d.Day == other.Day && d.Month == other.Month && d.Year == other.Year
+}
+
+func IsIslamicLeapYear(year int) bool {
+	return mod(14+11*year, 30) < 11
+}
+
+func DaysInIslamicMonth(year int, month IslamicMonth) int {
+	if month == DhuAlHijjah && IsIslamicLeapYear(year) || month%2 == 1 {
+		return 30
+	}
+	return 29
+}
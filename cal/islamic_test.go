@@ -0,0 +1,60 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestIslamicDateConversion(t *testing.T) {
+	for i, input := range []struct {
+		islamicDate *cal.IslamicDate
+		time        time.Time
+	}{
+		{
+			cal.NewIslamicDate(1, cal.Muharram, 1),
+			time.Date(622, time.July, 19, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewIslamicDate(1444, cal.Muharram, 1),
+			time.Date(2022, time.July, 30, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewIslamicDate(1445, cal.Ramadan, 1),
+			time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewIslamicDate(1443, cal.DhuAlHijjah, 29),
+			time.Date(2022, time.July, 29, 0, 0, 0, 0, time.UTC),
+		},
+	} {
+		date := cal.IslamicDateAt(input.time)
+		if !date.Equal(input.islamicDate) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.islamicDate, date)
+		}
+
+		timeStamp := input.islamicDate.ToUTCTime()
+		if !timeStamp.Equal(input.time) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.time, timeStamp)
+		}
+	}
+}
+
+func TestDaysInIslamicMonth(t *testing.T) {
+	for i, input := range []struct {
+		year  int
+		month cal.IslamicMonth
+		days  int
+	}{
+		{1443, cal.Muharram, 30},
+		{1443, cal.Safar, 29},
+		{1443, cal.DhuAlHijjah, 29},
+		{1445, cal.DhuAlHijjah, 30},
+	} {
+		days := cal.DaysInIslamicMonth(input.year, input.month)
+		if days != input.days {
+			t.Errorf("%d: Expected %d but found %d\n", i, input.days, days)
+		}
+	}
+}
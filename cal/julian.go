@@ -0,0 +1,86 @@
+package cal
+
+import "time"
+
+// Julian calendar dates use astronomical year numbering, like the time
+// package: the year before 1 CE is year 0.
+const julianEpoch = -1 // 1 January 1 (Julian) is 30 December 0 (Gregorian).
+
+type JulianDate struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+func NewJulianDate(year int, month time.Month, day int) *JulianDate {
+	return &JulianDate{Year: year, Month: month, Day: day}
+}
+
+func JulianDateAt(t time.Time) *JulianDate {
+	return JulianDateFromFixed(FixedFromTime(t))
+}
+
+func JulianDateFromFixed(fixed int) *JulianDate {
+	year := floorDiv(4*(fixed-julianEpoch)+1464, 1461)
+	priorDays := fixed - fixedFromJulian(year, time.January, 1)
+
+	correction := 0
+	if fixed >= fixedFromJulian(year, time.March, 1) {
+		if IsJulianLeapYear(year) {
+			correction = 1
+		} else {
+			correction = 2
+		}
+	}
+
+	month := time.Month(floorDiv(12*(priorDays+correction)+373, 367))
+	day := fixed - fixedFromJulian(year, month, 1) + 1
+	return NewJulianDate(year, month, day)
+}
+
+func fixedFromJulian(year int, month time.Month, day int) int {
+	correction := 0
+	if month > time.February {
+		if IsJulianLeapYear(year) {
+			correction = -1
+		} else {
+			correction = -2
+		}
+	}
+
+	return julianEpoch - 1 + 365*(year-1) + floorDiv(year-1, 4) +
+		floorDiv(367*int(month)-362, 12) + correction + day
+}
+
+func (d *JulianDate) Fixed() int {
+	return fixedFromJulian(d.Year, d.Month, d.Day)
+}
+
+func (d *JulianDate) ToUTCTime() time.Time {
+	return TimeFromFixed(d.Fixed())
+}
+
+func (d *JulianDate) ToIFCDate() *IFCDate {
+	return DateFromFixed(d.Fixed())
+}
+
+func (d *JulianDate) Equal(other *JulianDate) bool {
+	return d.Day == other.Day && d.Month == other.Month && d.Year == other.Year
+}
+
+func IsJulianLeapYear(year int) bool {
+	return mod(year, 4) == 0
+}
+
+func DaysInJulianMonth(year int, month time.Month) int {
+	switch month {
+	case time.February:
+		if IsJulianLeapYear(year) {
+			return 29
+		}
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	}
+	return 31
+}
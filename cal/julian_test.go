@@ -0,0 +1,58 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestJulianDateConversion(t *testing.T) {
+	for i, input := range []struct {
+		julianDate *cal.JulianDate
+		time       time.Time
+	}{
+		{
+			cal.NewJulianDate(1582, time.October, 5),
+			time.Date(1582, time.October, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewJulianDate(1582, time.October, 4),
+			time.Date(1582, time.October, 14, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewJulianDate(1700, time.February, 29),
+			time.Date(1700, time.March, 11, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewJulianDate(2021, time.December, 25),
+			time.Date(2022, time.January, 7, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewJulianDate(1, time.January, 1),
+			time.Date(0, time.December, 30, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewJulianDate(-43, time.March, 15), // 44 BCE
+			time.Date(-43, time.March, 13, 0, 0, 0, 0, time.UTC),
+		},
+	} {
+		date := cal.JulianDateAt(input.time)
+		if !date.Equal(input.julianDate) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.julianDate, date)
+		}
+
+		timeStamp := input.julianDate.ToUTCTime()
+		if !timeStamp.Equal(input.time) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.time, timeStamp)
+		}
+	}
+}
+
+func TestJulianToIFCDate(t *testing.T) {
+	date := cal.NewJulianDate(2020, time.June, 4).ToIFCDate()
+	expected := cal.NewIFCDate(2020, cal.June, 29)
+	if !date.Equal(expected) {
+		t.Errorf("Expected %+v but found %+v\n", expected, date)
+	}
+}
@@ -0,0 +1,492 @@
+package cal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LocaleData lists the names of a Locale. Months are given from January
+// to December, including Sol, and weekdays from Sunday to Saturday
+// followed by Leap Day and Year Day. Short weekday names are used as
+// column headers and should be two columns wide, such as two Latin letters
+// or one East Asian character.
+type LocaleData struct {
+	Tag               string
+	MonthNames        []string
+	ShortMonthNames   []string
+	WeekdayNames      []string
+	ShortWeekdayNames []string
+	// Ordinal returns the ordinal numeral of a positive number, such as
+	// "13th". If it is nil, the number is written as is.
+	Ordinal func(n int) string
+	// LongDate writes out a date as a phrase, such as "Friday, the 13th of
+	// Sol, 2022", numbering the year in the given era. If it is nil, the
+	// weekday, the ordinal day, the month and the year are written in that
+	// order.
+	LongDate func(l *Locale, date *IFCDate, era Era) string
+	// GregorianDate writes out a Gregorian date as a phrase, such as
+	// "Friday, May 13, 2022". If it is nil, the weekday, the ordinal day,
+	// the month and the year are written in that order.
+	GregorianDate func(l *Locale, t time.Time) string
+	// Messages translate the labels of the formatters and the names of
+	// holidays, keyed by their English text. Missing messages are written
+	// in English.
+	Messages map[string]string
+}
+
+// A Locale holds the month and weekday names of a language. Locales cannot
+// be changed after they have been created.
+type Locale struct {
+	tag               string
+	monthNames        []string
+	shortMonthNames   []string
+	weekdayNames      []string
+	shortWeekdayNames []string
+	ordinal           func(n int) string
+	longDate          func(l *Locale, date *IFCDate, era Era) string
+	gregorianDate     func(l *Locale, t time.Time) string
+	messages          map[string]string
+}
+
+func copyNames(kind string, names []string, count int) ([]string, error) {
+	if len(names) != count {
+		return nil, fmt.Errorf("locale has %d %s, expected %d", len(names), kind, count)
+	}
+	return append([]string(nil), names...), nil
+}
+
+func NewLocale(data LocaleData) (*Locale, error) {
+	l := &Locale{tag: data.Tag, ordinal: data.Ordinal, longDate: data.LongDate, gregorianDate: data.GregorianDate}
+	var err error
+	if l.monthNames, err = copyNames("month names", data.MonthNames, MonthsInYear); err != nil {
+		return nil, err
+	}
+	if l.shortMonthNames, err = copyNames("short month names", data.ShortMonthNames, MonthsInYear); err != nil {
+		return nil, err
+	}
+	if l.weekdayNames, err = copyNames("weekday names", data.WeekdayNames, int(YearDay)+1); err != nil {
+		return nil, err
+	}
+	if l.shortWeekdayNames, err = copyNames("short weekday names", data.ShortWeekdayNames, int(YearDay)+1); err != nil {
+		return nil, err
+	}
+	if l.ordinal == nil {
+		l.ordinal = strconv.Itoa
+	}
+	if l.longDate == nil {
+		l.longDate = defaultLongDate
+	}
+	if l.gregorianDate == nil {
+		l.gregorianDate = defaultGregorianDate
+	}
+	l.messages = make(map[string]string, len(data.Messages))
+	for text, translation := range data.Messages {
+		l.messages[text] = translation
+	}
+	return l, nil
+}
+
+func mustLocale(data LocaleData) *Locale {
+	l, err := NewLocale(data)
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+// Tag returns the language tag of the locale, such as "fi".
+func (l *Locale) Tag() string {
+	return l.tag
+}
+
+func (l *Locale) MonthName(m IFCMonth) string {
+	if m > 0 && m <= MonthsInYear {
+		return l.monthNames[m-1]
+	}
+	return fmt.Sprintf("%%!IFCMonth(%d)", int(m))
+}
+
+func (l *Locale) ShortMonthName(m IFCMonth) string {
+	if m > 0 && m <= MonthsInYear {
+		return l.shortMonthNames[m-1]
+	}
+	return fmt.Sprintf("%%!IFCMonth(%d)", int(m))
+}
+
+// GregorianMonthName returns the name of a Gregorian month, which is the
+// name of the IFC month with the same name in English.
+func (l *Locale) GregorianMonthName(m time.Month) string {
+	switch {
+	case m >= time.January && m <= time.June:
+		return l.monthNames[m-1]
+	case m >= time.July && m <= time.December:
+		return l.monthNames[m]
+	}
+	return fmt.Sprintf("%%!Month(%d)", int(m))
+}
+
+func (l *Locale) WeekdayName(wd Weekday) string {
+	if wd >= Sunday && wd <= YearDay {
+		return l.weekdayNames[wd]
+	}
+	return fmt.Sprintf("%%!Weekday(%d)", int(wd))
+}
+
+func (l *Locale) ShortWeekdayName(wd Weekday) string {
+	if wd >= Sunday && wd <= YearDay {
+		return l.shortWeekdayNames[wd]
+	}
+	return fmt.Sprintf("%%!Weekday(%d)", int(wd))
+}
+
+// minMonthPrefix is the shortest prefix of a month name that ParseMonth
+// accepts.
+const minMonthPrefix = 3
+
+// ParseMonth finds a month by its name, its short name or a prefix of at
+// least three letters that matches only one month. Case and a trailing
+// full stop are ignored.
+func (l *Locale) ParseMonth(name string) (IFCMonth, bool) {
+	name = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+	if name == "" {
+		return 0, false
+	}
+
+	var prefixMatch IFCMonth
+	prefixMatches := 0
+	for m := January; m <= December; m++ {
+		longName := strings.ToLower(l.MonthName(m))
+		if name == longName || name == strings.TrimSuffix(strings.ToLower(l.ShortMonthName(m)), ".") {
+			return m, true
+		}
+		if len([]rune(name)) >= minMonthPrefix && strings.HasPrefix(longName, name) {
+			prefixMatch = m
+			prefixMatches++
+		}
+	}
+	return prefixMatch, prefixMatches == 1
+}
+
+func (l *Locale) Ordinal(n int) string {
+	return l.ordinal(n)
+}
+
+// LongDate writes out a date as a phrase in the language of the locale,
+// numbering the year in the given era.
+func (l *Locale) LongDate(date *IFCDate, era Era) string {
+	return l.longDate(l, date, era)
+}
+
+// GregorianDate writes out a Gregorian date as a phrase in the language of
+// the locale.
+func (l *Locale) GregorianDate(t time.Time) string {
+	return l.gregorianDate(l, t)
+}
+
+// Message translates a label or a holiday name given in English. Texts
+// without a translation are returned as is.
+func (l *Locale) Message(text string) string {
+	if translation, ok := l.messages[text]; ok {
+		return translation
+	}
+	return text
+}
+
+func isIntercalary(date *IFCDate) bool {
+	return date.IsLeapDay() || date.IsYearDay()
+}
+
+func defaultLongDate(l *Locale, date *IFCDate, era Era) string {
+	if isIntercalary(date) {
+		return fmt.Sprintf("%s %s", l.WeekdayName(date.Weekday()), era.FormatYear(date.Year))
+	}
+	return fmt.Sprintf("%s %s %s %s",
+		l.WeekdayName(date.Weekday()), l.Ordinal(date.Day), l.MonthName(date.Month), era.FormatYear(date.Year))
+}
+
+func englishLongDate(l *Locale, date *IFCDate, era Era) string {
+	if isIntercalary(date) {
+		return fmt.Sprintf("%s, %s", l.WeekdayName(date.Weekday()), era.FormatYear(date.Year))
+	}
+	return fmt.Sprintf("%s, the %s of %s, %s",
+		l.WeekdayName(date.Weekday()), l.Ordinal(date.Day), l.MonthName(date.Month), era.FormatYear(date.Year))
+}
+
+func germanLongDate(l *Locale, date *IFCDate, era Era) string {
+	if isIntercalary(date) {
+		return defaultLongDate(l, date, era)
+	}
+	return fmt.Sprintf("%s, der %s %s %s",
+		l.WeekdayName(date.Weekday()), l.Ordinal(date.Day), l.MonthName(date.Month), era.FormatYear(date.Year))
+}
+
+// French dates use an ordinal only for the first of the month.
+func frenchLongDate(l *Locale, date *IFCDate, era Era) string {
+	if isIntercalary(date) || date.Day == 1 {
+		return defaultLongDate(l, date, era)
+	}
+	return fmt.Sprintf("%s %d %s %s",
+		l.WeekdayName(date.Weekday()), date.Day, l.MonthName(date.Month), era.FormatYear(date.Year))
+}
+
+func spanishLongDate(l *Locale, date *IFCDate, era Era) string {
+	if isIntercalary(date) {
+		return fmt.Sprintf("%s de %s", l.WeekdayName(date.Weekday()), era.FormatYear(date.Year))
+	}
+	return fmt.Sprintf("%s, %d de %s de %s",
+		l.WeekdayName(date.Weekday()), date.Day, l.MonthName(date.Month), era.FormatYear(date.Year))
+}
+
+// Japanese writes the year with a 年 suffix, and the Holocene era with the
+// prefix 人類紀元 instead of HE.
+func japaneseLongDate(l *Locale, date *IFCDate, era Era) string {
+	year := fmt.Sprintf("%d年", era.Year(date.Year))
+	if era == HoloceneEra {
+		year = "人類紀元" + year
+	}
+	if isIntercalary(date) {
+		return fmt.Sprintf("%s %s", year, l.WeekdayName(date.Weekday()))
+	}
+	return fmt.Sprintf("%s%s%s %s", year, l.MonthName(date.Month), l.Ordinal(date.Day), l.WeekdayName(date.Weekday()))
+}
+
+// gregorianWeekdayName names the weekday of a Gregorian date, which has the
+// same name as the IFC weekday with the same number.
+func gregorianWeekdayName(l *Locale, t time.Time) string {
+	return l.WeekdayName(Weekday(t.Weekday()))
+}
+
+func defaultGregorianDate(l *Locale, t time.Time) string {
+	return fmt.Sprintf("%s %s %s %d",
+		gregorianWeekdayName(l, t), l.Ordinal(t.Day()), l.GregorianMonthName(t.Month()), t.Year())
+}
+
+func englishGregorianDate(l *Locale, t time.Time) string {
+	return fmt.Sprintf("%s, %s %d, %d",
+		gregorianWeekdayName(l, t), l.GregorianMonthName(t.Month()), t.Day(), t.Year())
+}
+
+func germanGregorianDate(l *Locale, t time.Time) string {
+	return fmt.Sprintf("%s, %s %s %d",
+		gregorianWeekdayName(l, t), l.Ordinal(t.Day()), l.GregorianMonthName(t.Month()), t.Year())
+}
+
+func frenchGregorianDate(l *Locale, t time.Time) string {
+	if t.Day() == 1 {
+		return defaultGregorianDate(l, t)
+	}
+	return fmt.Sprintf("%s %d %s %d",
+		gregorianWeekdayName(l, t), t.Day(), l.GregorianMonthName(t.Month()), t.Year())
+}
+
+func spanishGregorianDate(l *Locale, t time.Time) string {
+	return fmt.Sprintf("%s, %d de %s de %d",
+		gregorianWeekdayName(l, t), t.Day(), l.GregorianMonthName(t.Month()), t.Year())
+}
+
+func japaneseGregorianDate(l *Locale, t time.Time) string {
+	return fmt.Sprintf("%d年%s%s %s",
+		t.Year(), l.GregorianMonthName(t.Month()), l.Ordinal(t.Day()), gregorianWeekdayName(l, t))
+}
+
+func englishOrdinal(n int) string {
+	suffix := "th"
+	if n%100 < 11 || n%100 > 13 {
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return strconv.Itoa(n) + suffix
+}
+
+// Finnish and German write ordinal numbers with a full stop.
+func ordinalWithFullStop(n int) string {
+	return strconv.Itoa(n) + "."
+}
+
+func frenchOrdinal(n int) string {
+	if n == 1 {
+		return "1er"
+	}
+	return strconv.Itoa(n) + "e"
+}
+
+func spanishOrdinal(n int) string {
+	return strconv.Itoa(n) + "º"
+}
+
+func japaneseOrdinal(n int) string {
+	return strconv.Itoa(n) + "日"
+}
+
+var english = mustLocale(LocaleData{
+	Tag: "en",
+	MonthNames: []string{
+		"January", "February", "March", "April", "May", "June", "Sol",
+		"July", "August", "September", "October", "November", "December",
+	},
+	ShortMonthNames: []string{
+		"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Sol",
+		"Jul", "Aug", "Sep", "Oct", "Nov", "Dec",
+	},
+	WeekdayNames: []string{
+		"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+		"Leap Day", "Year Day",
+	},
+	ShortWeekdayNames: []string{"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa", "LD", "YD"},
+	Ordinal:           englishOrdinal,
+	LongDate:          englishLongDate,
+	GregorianDate:     englishGregorianDate,
+})
+
+var finnish = mustLocale(LocaleData{
+	Tag: "fi",
+	MonthNames: []string{
+		"tammikuu", "helmikuu", "maaliskuu", "huhtikuu", "toukokuu", "kesäkuu", "sol",
+		"heinäkuu", "elokuu", "syyskuu", "lokakuu", "marraskuu", "joulukuu",
+	},
+	ShortMonthNames: []string{
+		"tammi", "helmi", "maalis", "huhti", "touko", "kesä", "sol",
+		"heinä", "elo", "syys", "loka", "marras", "joulu",
+	},
+	WeekdayNames: []string{
+		"sunnuntai", "maanantai", "tiistai", "keskiviikko", "torstai", "perjantai", "lauantai",
+		"karkauspäivä", "vuodenpäivä",
+	},
+	ShortWeekdayNames: []string{"su", "ma", "ti", "ke", "to", "pe", "la", "KP", "VP"},
+	Ordinal:           ordinalWithFullStop,
+	Messages:          finnishMessages,
+})
+
+var german = mustLocale(LocaleData{
+	Tag: "de",
+	MonthNames: []string{
+		"Januar", "Februar", "März", "April", "Mai", "Juni", "Sol",
+		"Juli", "August", "September", "Oktober", "November", "Dezember",
+	},
+	ShortMonthNames: []string{
+		"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Sol",
+		"Jul", "Aug", "Sep", "Okt", "Nov", "Dez",
+	},
+	WeekdayNames: []string{
+		"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag",
+		"Schalttag", "Jahrestag",
+	},
+	ShortWeekdayNames: []string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa", "ST", "JT"},
+	Ordinal:           ordinalWithFullStop,
+	LongDate:          germanLongDate,
+	GregorianDate:     germanGregorianDate,
+	Messages:          germanMessages,
+})
+
+var french = mustLocale(LocaleData{
+	Tag: "fr",
+	MonthNames: []string{
+		"janvier", "février", "mars", "avril", "mai", "juin", "sol",
+		"juillet", "août", "septembre", "octobre", "novembre", "décembre",
+	},
+	ShortMonthNames: []string{
+		"janv.", "févr.", "mars", "avr.", "mai", "juin", "sol",
+		"juil.", "août", "sept.", "oct.", "nov.", "déc.",
+	},
+	WeekdayNames: []string{
+		"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi",
+		"jour bissextile", "jour de l'année",
+	},
+	ShortWeekdayNames: []string{"di", "lu", "ma", "me", "je", "ve", "sa", "JB", "JA"},
+	Ordinal:           frenchOrdinal,
+	LongDate:          frenchLongDate,
+	GregorianDate:     frenchGregorianDate,
+	Messages:          frenchMessages,
+})
+
+var spanish = mustLocale(LocaleData{
+	Tag: "es",
+	MonthNames: []string{
+		"enero", "febrero", "marzo", "abril", "mayo", "junio", "sol",
+		"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre",
+	},
+	ShortMonthNames: []string{
+		"ene", "feb", "mar", "abr", "may", "jun", "sol",
+		"jul", "ago", "sept", "oct", "nov", "dic",
+	},
+	WeekdayNames: []string{
+		"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado",
+		"día bisiesto", "día del año",
+	},
+	ShortWeekdayNames: []string{"do", "lu", "ma", "mi", "ju", "vi", "sá", "DB", "DA"},
+	Ordinal:           spanishOrdinal,
+	LongDate:          spanishLongDate,
+	GregorianDate:     spanishGregorianDate,
+	Messages:          spanishMessages,
+})
+
+var japanese = mustLocale(LocaleData{
+	Tag: "ja",
+	MonthNames: []string{
+		"1月", "2月", "3月", "4月", "5月", "6月", "ソル月",
+		"7月", "8月", "9月", "10月", "11月", "12月",
+	},
+	ShortMonthNames: []string{
+		"1月", "2月", "3月", "4月", "5月", "6月", "ソル",
+		"7月", "8月", "9月", "10月", "11月", "12月",
+	},
+	WeekdayNames: []string{
+		"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日",
+		"閏日", "年末日",
+	},
+	ShortWeekdayNames: []string{"日", "月", "火", "水", "木", "金", "土", "閏", "年"},
+	Ordinal:           japaneseOrdinal,
+	LongDate:          japaneseLongDate,
+	GregorianDate:     japaneseGregorianDate,
+	Messages:          japaneseMessages,
+})
+
+// EnglishLocale returns the English names, which are the default.
+func EnglishLocale() *Locale {
+	return english
+}
+
+func FinnishLocale() *Locale {
+	return finnish
+}
+
+func GermanLocale() *Locale {
+	return german
+}
+
+func FrenchLocale() *Locale {
+	return french
+}
+
+func SpanishLocale() *Locale {
+	return spanish
+}
+
+func JapaneseLocale() *Locale {
+	return japanese
+}
+
+var locales = []*Locale{english, finnish, german, french, spanish, japanese}
+
+// LookupLocale finds a built-in locale by a language tag or a POSIX locale
+// name such as "fi", "de-AT" or "fr_FR.UTF-8".
+func LookupLocale(name string) (*Locale, bool) {
+	tag := strings.ToLower(name)
+	if i := strings.IndexAny(tag, "_-.@"); i >= 0 {
+		tag = tag[:i]
+	}
+	for _, l := range locales {
+		if l.tag == tag {
+			return l, true
+		}
+	}
+	return nil, false
+}
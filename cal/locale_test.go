@@ -0,0 +1,192 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestLocaleNames(t *testing.T) {
+	for i, input := range []struct {
+		locale  *cal.Locale
+		month   cal.IFCMonth
+		weekday cal.Weekday
+		names   []string
+	}{
+		{cal.EnglishLocale(), cal.Sol, cal.Friday, []string{"Sol", "Sol", "Friday", "Fr"}},
+		{cal.FinnishLocale(), cal.January, cal.LeapDay, []string{"tammikuu", "tammi", "karkauspäivä", "KP"}},
+		{cal.GermanLocale(), cal.March, cal.Wednesday, []string{"März", "Mär", "Mittwoch", "Mi"}},
+		{cal.FrenchLocale(), cal.August, cal.YearDay, []string{"août", "août", "jour de l'année", "JA"}},
+		{cal.SpanishLocale(), cal.December, cal.Saturday, []string{"diciembre", "dic", "sábado", "sá"}},
+		{cal.JapaneseLocale(), cal.Sol, cal.Sunday, []string{"ソル月", "ソル", "日曜日", "日"}},
+		{cal.JapaneseLocale(), cal.December, cal.YearDay, []string{"12月", "12月", "年末日", "年"}},
+	} {
+		names := []string{
+			input.locale.MonthName(input.month),
+			input.locale.ShortMonthName(input.month),
+			input.locale.WeekdayName(input.weekday),
+			input.locale.ShortWeekdayName(input.weekday),
+		}
+		for j := range names {
+			if names[j] != input.names[j] {
+				t.Errorf("%d: Expected '%s' but found '%s'\n", i, input.names[j], names[j])
+			}
+		}
+	}
+}
+
+func TestGregorianMonthNames(t *testing.T) {
+	for i, input := range []struct {
+		locale *cal.Locale
+		month  time.Month
+		name   string
+	}{
+		{cal.EnglishLocale(), time.June, "June"},
+		{cal.EnglishLocale(), time.July, "July"},
+		{cal.FinnishLocale(), time.July, "heinäkuu"},
+		{cal.JapaneseLocale(), time.December, "12月"},
+		{cal.GermanLocale(), time.Month(13), "%!Month(13)"},
+	} {
+		if name := input.locale.GregorianMonthName(input.month); name != input.name {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, input.name, name)
+		}
+	}
+}
+
+func TestGregorianDates(t *testing.T) {
+	date := time.Date(2022, time.May, 13, 0, 0, 0, 0, time.UTC)
+	for i, input := range []struct {
+		locale *cal.Locale
+		date   time.Time
+		result string
+	}{
+		{cal.EnglishLocale(), date, "Friday, May 13, 2022"},
+		{cal.FinnishLocale(), date, "perjantai 13. toukokuu 2022"},
+		{cal.GermanLocale(), date, "Freitag, 13. Mai 2022"},
+		{cal.FrenchLocale(), date, "vendredi 13 mai 2022"},
+		{cal.FrenchLocale(), time.Date(2022, time.July, 1, 0, 0, 0, 0, time.UTC), "vendredi 1er juillet 2022"},
+		{cal.SpanishLocale(), date, "viernes, 13 de mayo de 2022"},
+		{cal.JapaneseLocale(), date, "2022年5月13日 金曜日"},
+	} {
+		if result := input.locale.GregorianDate(input.date); result != input.result {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, input.result, result)
+		}
+	}
+}
+
+func TestMessages(t *testing.T) {
+	if message := cal.FinnishLocale().Message("Sunrise"); message != "Auringonnousu" {
+		t.Errorf("Expected a translated message but found '%s'\n", message)
+	}
+	if message := cal.FinnishLocale().Message("IFC"); message != "IFC" {
+		t.Errorf("Expected the English text but found '%s'\n", message)
+	}
+}
+
+func TestOrdinals(t *testing.T) {
+	for i, input := range []struct {
+		locale  *cal.Locale
+		numbers []int
+		result  []string
+	}{
+		{cal.EnglishLocale(), []int{1, 2, 3, 4, 11, 12, 13, 21, 22, 23, 28, 101, 111}, []string{"1st", "2nd", "3rd", "4th", "11th", "12th", "13th", "21st", "22nd", "23rd", "28th", "101st", "111th"}},
+		{cal.FinnishLocale(), []int{1, 13}, []string{"1.", "13."}},
+		{cal.FrenchLocale(), []int{1, 2}, []string{"1er", "2e"}},
+		{cal.SpanishLocale(), []int{13}, []string{"13º"}},
+		{cal.JapaneseLocale(), []int{13}, []string{"13日"}},
+	} {
+		for j, n := range input.numbers {
+			if ordinal := input.locale.Ordinal(n); ordinal != input.result[j] {
+				t.Errorf("%d: Expected '%s' but found '%s'\n", i, input.result[j], ordinal)
+			}
+		}
+	}
+}
+
+func TestNewLocale(t *testing.T) {
+	names := []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13"}
+	weekdays := []string{"A", "B", "C", "D", "E", "F", "G", "H", "I"}
+	messages := map[string]string{"Sunrise": "Up"}
+	locale, err := cal.NewLocale(cal.LocaleData{
+		Tag:               "xx",
+		MonthNames:        names,
+		ShortMonthNames:   names,
+		WeekdayNames:      weekdays,
+		ShortWeekdayNames: weekdays,
+		Messages:          messages,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error %s\n", err)
+	}
+
+	names[0] = "changed"
+	messages["Sunrise"] = "changed"
+	if name := locale.MonthName(cal.January); name != "1" {
+		t.Errorf("Expected the locale to keep its own names but found '%s'\n", name)
+	}
+	if message := locale.Message("Sunrise"); message != "Up" {
+		t.Errorf("Expected the locale to keep its own messages but found '%s'\n", message)
+	}
+	if ordinal := locale.Ordinal(3); ordinal != "3" {
+		t.Errorf("Expected a plain number but found '%s'\n", ordinal)
+	}
+	if date := locale.LongDate(cal.NewIFCDate(2022, cal.January, 3), cal.HoloceneEra); date != "C 3 1 12022 HE" {
+		t.Errorf("Expected the default long date but found '%s'\n", date)
+	}
+	if date := locale.GregorianDate(time.Date(2022, time.January, 3, 0, 0, 0, 0, time.UTC)); date != "B 3 1 2022" {
+		t.Errorf("Expected the default Gregorian date but found '%s'\n", date)
+	}
+
+	if _, err := cal.NewLocale(cal.LocaleData{MonthNames: names[:12]}); err == nil {
+		t.Errorf("Expected an error for a locale with 12 months\n")
+	}
+}
+
+func TestLookupLocale(t *testing.T) {
+	for i, input := range []struct {
+		name   string
+		locale *cal.Locale
+	}{
+		{"fi", cal.FinnishLocale()},
+		{"fi_FI.UTF-8", cal.FinnishLocale()},
+		{"de-AT", cal.GermanLocale()},
+		{"ja_JP", cal.JapaneseLocale()},
+		{"en_US.UTF-8", cal.EnglishLocale()},
+		{"sv_SE", nil},
+	} {
+		locale, ok := cal.LookupLocale(input.name)
+		if locale != input.locale || ok != (input.locale != nil) {
+			t.Errorf("%d: Expected %v for %s but found %v\n", i, input.locale, input.name, locale)
+		}
+	}
+}
+
+func TestParseMonth(t *testing.T) {
+	for i, input := range []struct {
+		locale *cal.Locale
+		name   string
+		month  cal.IFCMonth
+		ok     bool
+	}{
+		{cal.EnglishLocale(), "sol", cal.Sol, true},
+		{cal.EnglishLocale(), "Jan", cal.January, true},
+		{cal.EnglishLocale(), "sep", cal.September, true},
+		{cal.EnglishLocale(), "Septem", cal.September, true},
+		{cal.EnglishLocale(), "ju", 0, false},
+		{cal.FinnishLocale(), "tammikuu", cal.January, true},
+		{cal.FinnishLocale(), "Heinä", cal.July, true},
+		{cal.FinnishLocale(), "syys", cal.September, true},
+		{cal.FrenchLocale(), "févr.", cal.February, true},
+		{cal.FrenchLocale(), "juil", cal.July, true},
+		{cal.GermanLocale(), "märz", cal.March, true},
+		{cal.JapaneseLocale(), "ソル", cal.Sol, true},
+		{cal.SpanishLocale(), "enero", cal.January, true},
+		{cal.SpanishLocale(), "foo", 0, false},
+	} {
+		month, ok := input.locale.ParseMonth(input.name)
+		if ok != input.ok || ok && month != input.month {
+			t.Errorf("%d: Expected %s (%t) for '%s' but found %s (%t)\n", i, input.month, input.ok, input.name, month, ok)
+		}
+	}
+}
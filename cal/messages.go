@@ -0,0 +1,264 @@
+package cal
+
+// The messages of the built-in locales translate the labels of the text
+// formatters and the names of holidays. English needs none, as the
+// messages are keyed by their English text. The US federal holidays and
+// the UK bank holidays that are not observed elsewhere keep their English
+// names.
+
+var finnishMessages = map[string]string{
+	"Gregorian":                     "gregoriaaninen",
+	"%s (Gregorian)":                "%s (gregoriaaninen kalenteri)",
+	"Fiscal year %s (%s, %d weeks)": "Tilikausi %s (%s, %d viikkoa)",
+	"13 periods":                    "13 jaksoa",
+	"Qtr":                           "Nel",
+	"Per":                           "Jak",
+	"Wks":                           "Vko",
+	"Civil dawn":                    "Aamuhämärä",
+	"Sunrise":                       "Auringonnousu",
+	"Solar noon":                    "Keskipäivä",
+	"Sunset":                        "Auringonlasku",
+	"Civil dusk":                    "Iltahämärä",
+	"Day length":                    "Päivän pituus",
+	"(midnight sun)":                "(yötön yö)",
+	"(polar night)":                 "(kaamos)",
+
+	// Holidays, feasts, seasons and phases of the Moon.
+	"Leap Day":          "karkauspäivä",
+	"Year Day":          "vuodenpäivä",
+	"New Year's Day":    "uudenvuodenpäivä",
+	"Epiphany":          "loppiainen",
+	"Ash Wednesday":     "tuhkakeskiviikko",
+	"Clean Monday":      "puhdas maanantai",
+	"Palm Sunday":       "palmusunnuntai",
+	"Good Friday":       "pitkäperjantai",
+	"Easter Sunday":     "pääsiäispäivä",
+	"Easter Monday":     "2. pääsiäispäivä",
+	"May Day":           "vappu",
+	"Ascension Day":     "helatorstai",
+	"Pentecost":         "helluntai",
+	"Whitsunday":        "helluntaipäivä",
+	"Trinity Sunday":    "pyhän kolminaisuuden päivä",
+	"Corpus Christi":    "Kristuksen ruumiin ja veren juhla",
+	"Midsummer Eve":     "juhannusaatto",
+	"Midsummer Day":     "juhannuspäivä",
+	"All Saints' Day":   "pyhäinpäivä",
+	"Independence Day":  "itsenäisyyspäivä",
+	"Christmas Eve":     "jouluaatto",
+	"Christmas Day":     "joulupäivä",
+	"St. Stephen's Day": "tapaninpäivä",
+	"March equinox":     "kevätpäiväntasaus",
+	"June solstice":     "kesäpäivänseisaus",
+	"September equinox": "syyspäiväntasaus",
+	"December solstice": "talvipäivänseisaus",
+	"New moon":          "uusikuu",
+	"First quarter":     "kasvava puolikuu",
+	"Full moon":         "täysikuu",
+	"Last quarter":      "vähenevä puolikuu",
+}
+
+var germanMessages = map[string]string{
+	"Gregorian":                     "Gregorianisch",
+	"%s (Gregorian)":                "%s (gregorianisch)",
+	"Fiscal year %s (%s, %d weeks)": "Geschäftsjahr %s (%s, %d Wochen)",
+	"13 periods":                    "13 Perioden",
+	"Qtr":                           "Qu.",
+	"Per":                           "Per",
+	"Wks":                           "Wo.",
+	"Civil dawn":                    "Morgendämmerung",
+	"Sunrise":                       "Sonnenaufgang",
+	"Solar noon":                    "Sonnenhöchststand",
+	"Sunset":                        "Sonnenuntergang",
+	"Civil dusk":                    "Abenddämmerung",
+	"Day length":                    "Tageslänge",
+	"(midnight sun)":                "(Mitternachtssonne)",
+	"(polar night)":                 "(Polarnacht)",
+
+	// Holidays, feasts, seasons and phases of the Moon.
+	"Leap Day":          "Schalttag",
+	"Year Day":          "Jahrestag",
+	"New Year's Day":    "Neujahr",
+	"Epiphany":          "Heilige Drei Könige",
+	"Ash Wednesday":     "Aschermittwoch",
+	"Clean Monday":      "Reiner Montag",
+	"Palm Sunday":       "Palmsonntag",
+	"Good Friday":       "Karfreitag",
+	"Easter Sunday":     "Ostersonntag",
+	"Easter Monday":     "Ostermontag",
+	"May Day":           "Maifeiertag",
+	"Ascension Day":     "Christi Himmelfahrt",
+	"Pentecost":         "Pfingsten",
+	"Whitsunday":        "Pfingstsonntag",
+	"Trinity Sunday":    "Trinitatis",
+	"Corpus Christi":    "Fronleichnam",
+	"Midsummer Eve":     "Mittsommerabend",
+	"Midsummer Day":     "Mittsommertag",
+	"All Saints' Day":   "Allerheiligen",
+	"Independence Day":  "Unabhängigkeitstag",
+	"Christmas Eve":     "Heiligabend",
+	"Christmas Day":     "erster Weihnachtstag",
+	"St. Stephen's Day": "zweiter Weihnachtstag",
+	"March equinox":     "März-Tagundnachtgleiche",
+	"June solstice":     "Juni-Sonnenwende",
+	"September equinox": "September-Tagundnachtgleiche",
+	"December solstice": "Dezember-Sonnenwende",
+	"New moon":          "Neumond",
+	"First quarter":     "erstes Viertel",
+	"Full moon":         "Vollmond",
+	"Last quarter":      "letztes Viertel",
+}
+
+var frenchMessages = map[string]string{
+	"Gregorian":                     "Grégorien",
+	"%s (Gregorian)":                "%s (grégorien)",
+	"Fiscal year %s (%s, %d weeks)": "Exercice %s (%s, %d semaines)",
+	"13 periods":                    "13 périodes",
+	"Qtr":                           "Tri",
+	"Per":                           "Pér",
+	"Wks":                           "Sem",
+	"Civil dawn":                    "Aube civile",
+	"Sunrise":                       "Lever du soleil",
+	"Solar noon":                    "Midi solaire",
+	"Sunset":                        "Coucher du soleil",
+	"Civil dusk":                    "Crépuscule civil",
+	"Day length":                    "Durée du jour",
+	"(midnight sun)":                "(soleil de minuit)",
+	"(polar night)":                 "(nuit polaire)",
+
+	// Holidays, feasts, seasons and phases of the Moon.
+	"Leap Day":          "jour bissextile",
+	"Year Day":          "jour de l'année",
+	"New Year's Day":    "jour de l'An",
+	"Epiphany":          "Épiphanie",
+	"Ash Wednesday":     "mercredi des Cendres",
+	"Clean Monday":      "lundi pur",
+	"Palm Sunday":       "dimanche des Rameaux",
+	"Good Friday":       "vendredi saint",
+	"Easter Sunday":     "dimanche de Pâques",
+	"Easter Monday":     "lundi de Pâques",
+	"May Day":           "fête du Travail",
+	"Ascension Day":     "Ascension",
+	"Pentecost":         "Pentecôte",
+	"Whitsunday":        "dimanche de Pentecôte",
+	"Trinity Sunday":    "Trinité",
+	"Corpus Christi":    "Fête-Dieu",
+	"Midsummer Eve":     "veille de la Saint-Jean",
+	"Midsummer Day":     "Saint-Jean",
+	"All Saints' Day":   "Toussaint",
+	"Independence Day":  "fête de l'Indépendance",
+	"Christmas Eve":     "veille de Noël",
+	"Christmas Day":     "Noël",
+	"St. Stephen's Day": "Saint-Étienne",
+	"March equinox":     "équinoxe de mars",
+	"June solstice":     "solstice de juin",
+	"September equinox": "équinoxe de septembre",
+	"December solstice": "solstice de décembre",
+	"New moon":          "nouvelle lune",
+	"First quarter":     "premier quartier",
+	"Full moon":         "pleine lune",
+	"Last quarter":      "dernier quartier",
+}
+
+var spanishMessages = map[string]string{
+	"Gregorian":                     "Gregoriano",
+	"%s (Gregorian)":                "%s (gregoriano)",
+	"Fiscal year %s (%s, %d weeks)": "Año fiscal %s (%s, %d semanas)",
+	"13 periods":                    "13 períodos",
+	"Qtr":                           "Tri",
+	"Per":                           "Per",
+	"Wks":                           "Sem",
+	"Civil dawn":                    "Alba civil",
+	"Sunrise":                       "Salida del sol",
+	"Solar noon":                    "Mediodía solar",
+	"Sunset":                        "Puesta del sol",
+	"Civil dusk":                    "Ocaso civil",
+	"Day length":                    "Duración del día",
+	"(midnight sun)":                "(sol de medianoche)",
+	"(polar night)":                 "(noche polar)",
+
+	// Holidays, feasts, seasons and phases of the Moon.
+	"Leap Day":          "día bisiesto",
+	"Year Day":          "día del año",
+	"New Year's Day":    "Año Nuevo",
+	"Epiphany":          "Epifanía",
+	"Ash Wednesday":     "miércoles de Ceniza",
+	"Clean Monday":      "lunes limpio",
+	"Palm Sunday":       "domingo de Ramos",
+	"Good Friday":       "viernes santo",
+	"Easter Sunday":     "domingo de Pascua",
+	"Easter Monday":     "lunes de Pascua",
+	"May Day":           "Primero de Mayo",
+	"Ascension Day":     "Ascensión",
+	"Pentecost":         "Pentecostés",
+	"Whitsunday":        "domingo de Pentecostés",
+	"Trinity Sunday":    "Santísima Trinidad",
+	"Corpus Christi":    "Corpus Christi",
+	"Midsummer Eve":     "víspera de San Juan",
+	"Midsummer Day":     "día de San Juan",
+	"All Saints' Day":   "Todos los Santos",
+	"Independence Day":  "día de la Independencia",
+	"Christmas Eve":     "Nochebuena",
+	"Christmas Day":     "Navidad",
+	"St. Stephen's Day": "San Esteban",
+	"March equinox":     "equinoccio de marzo",
+	"June solstice":     "solsticio de junio",
+	"September equinox": "equinoccio de septiembre",
+	"December solstice": "solsticio de diciembre",
+	"New moon":          "luna nueva",
+	"First quarter":     "cuarto creciente",
+	"Full moon":         "luna llena",
+	"Last quarter":      "cuarto menguante",
+}
+
+var japaneseMessages = map[string]string{
+	"Gregorian":                     "グレゴリオ暦",
+	"IFC":                           "国際固定暦",
+	"%s (IFC)":                      "%s(国際固定暦)",
+	"%s (Gregorian)":                "%s(グレゴリオ暦)",
+	"Fiscal year %s (%s, %d weeks)": "%s会計年度 (%s、%d週)",
+	"13 periods":                    "13期間",
+	"Qtr":                           "四半期",
+	"Per":                           "期間",
+	"Wks":                           "週",
+	"Civil dawn":                    "市民薄明の始まり",
+	"Sunrise":                       "日の出",
+	"Solar noon":                    "南中",
+	"Sunset":                        "日の入り",
+	"Civil dusk":                    "市民薄明の終わり",
+	"Day length":                    "昼の長さ",
+	"(midnight sun)":                "(白夜)",
+	"(polar night)":                 "(極夜)",
+
+	// Holidays, feasts, seasons and phases of the Moon.
+	"Leap Day":          "閏日",
+	"Year Day":          "年末日",
+	"New Year's Day":    "元日",
+	"Epiphany":          "公現祭",
+	"Ash Wednesday":     "灰の水曜日",
+	"Clean Monday":      "清浄月曜日",
+	"Palm Sunday":       "枝の主日",
+	"Good Friday":       "聖金曜日",
+	"Easter Sunday":     "復活祭",
+	"Easter Monday":     "復活祭翌日",
+	"May Day":           "メーデー",
+	"Ascension Day":     "昇天祭",
+	"Pentecost":         "聖霊降臨祭",
+	"Whitsunday":        "聖霊降臨祭",
+	"Trinity Sunday":    "三位一体の主日",
+	"Corpus Christi":    "キリストの聖体",
+	"Midsummer Eve":     "夏至祭前夜",
+	"Midsummer Day":     "夏至祭",
+	"All Saints' Day":   "諸聖人の日",
+	"Independence Day":  "独立記念日",
+	"Christmas Eve":     "クリスマス・イブ",
+	"Christmas Day":     "クリスマス",
+	"St. Stephen's Day": "聖ステファノの日",
+	"March equinox":     "春分",
+	"June solstice":     "夏至",
+	"September equinox": "秋分",
+	"December solstice": "冬至",
+	"New moon":          "新月",
+	"First quarter":     "上弦",
+	"Full moon":         "満月",
+	"Last quarter":      "下弦",
+}
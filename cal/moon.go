@@ -0,0 +1,198 @@
+package cal
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// A MoonPhase is one of the four principal phases of the Moon.
+type MoonPhase int
+
+const (
+	NewMoon MoonPhase = iota
+	FirstQuarter
+	FullMoon
+	LastQuarter
+)
+
+var moonPhaseNames = []string{
+	"New moon",
+	"First quarter",
+	"Full moon",
+	"Last quarter",
+}
+
+func (p MoonPhase) String() string {
+	if p >= NewMoon && p <= LastQuarter {
+		return moonPhaseNames[p]
+	}
+	return fmt.Sprintf("%%!MoonPhase(%d)", int(p))
+}
+
+// A LunarPhase is the instant of a principal phase of the Moon.
+type LunarPhase struct {
+	Phase MoonPhase
+	Time  time.Time
+}
+
+// Date returns the IFC date of the phase in the given location.
+func (p LunarPhase) Date(loc *time.Location) *IFCDate {
+	return DateAt(p.Time.In(loc))
+}
+
+const (
+	synodicMonth    = 29.530588861
+	lunationsInYear = 12.3685
+)
+
+// A lunarTerm is a periodic term coefficient * E^ePower * sin(mPrime*M' +
+// m*M + f*F + omega*Ω) of the phase corrections in Meeus, chapter 49.
+type lunarTerm struct {
+	coefficient         float64
+	ePower              int
+	mPrime, m, f, omega float64
+}
+
+var newMoonTerms = []lunarTerm{
+	{-0.40720, 0, 1, 0, 0, 0}, {0.17241, 1, 0, 1, 0, 0}, {0.01608, 0, 2, 0, 0, 0},
+	{0.01039, 0, 0, 0, 2, 0}, {0.00739, 1, 1, -1, 0, 0}, {-0.00514, 1, 1, 1, 0, 0},
+	{0.00208, 2, 0, 2, 0, 0}, {-0.00111, 0, 1, 0, -2, 0}, {-0.00057, 0, 1, 0, 2, 0},
+	{0.00056, 1, 2, 1, 0, 0}, {-0.00042, 0, 3, 0, 0, 0}, {0.00042, 1, 0, 1, 2, 0},
+	{0.00038, 1, 0, 1, -2, 0}, {-0.00024, 1, 2, -1, 0, 0}, {-0.00017, 0, 0, 0, 0, 1},
+	{-0.00007, 0, 1, 2, 0, 0}, {0.00004, 0, 2, 0, -2, 0}, {0.00004, 0, 0, 3, 0, 0},
+	{0.00003, 0, 1, 1, -2, 0}, {0.00003, 0, 2, 0, 2, 0}, {-0.00003, 0, 1, 1, 2, 0},
+	{0.00003, 0, 1, -1, 2, 0}, {-0.00002, 0, 1, -1, -2, 0}, {-0.00002, 0, 3, 1, 0, 0},
+	{0.00002, 0, 4, 0, 0, 0},
+}
+
+var fullMoonTerms = []lunarTerm{
+	{-0.40614, 0, 1, 0, 0, 0}, {0.17302, 1, 0, 1, 0, 0}, {0.01614, 0, 2, 0, 0, 0},
+	{0.01043, 0, 0, 0, 2, 0}, {0.00734, 1, 1, -1, 0, 0}, {-0.00515, 1, 1, 1, 0, 0},
+	{0.00209, 2, 0, 2, 0, 0}, {-0.00111, 0, 1, 0, -2, 0}, {-0.00057, 0, 1, 0, 2, 0},
+	{0.00056, 1, 2, 1, 0, 0}, {-0.00042, 0, 3, 0, 0, 0}, {0.00042, 1, 0, 1, 2, 0},
+	{0.00038, 1, 0, 1, -2, 0}, {-0.00024, 1, 2, -1, 0, 0}, {-0.00017, 0, 0, 0, 0, 1},
+	{-0.00007, 0, 1, 2, 0, 0}, {0.00004, 0, 2, 0, -2, 0}, {0.00004, 0, 0, 3, 0, 0},
+	{0.00003, 0, 1, 1, -2, 0}, {0.00003, 0, 2, 0, 2, 0}, {-0.00003, 0, 1, 1, 2, 0},
+	{0.00003, 0, 1, -1, 2, 0}, {-0.00002, 0, 1, -1, -2, 0}, {-0.00002, 0, 3, 1, 0, 0},
+	{0.00002, 0, 4, 0, 0, 0},
+}
+
+var quarterTerms = []lunarTerm{
+	{-0.62801, 0, 1, 0, 0, 0}, {0.17172, 1, 0, 1, 0, 0}, {-0.01183, 1, 1, 1, 0, 0},
+	{0.00862, 0, 2, 0, 0, 0}, {0.00804, 0, 0, 0, 2, 0}, {0.00454, 1, 1, -1, 0, 0},
+	{0.00204, 2, 0, 2, 0, 0}, {-0.00180, 0, 1, 0, -2, 0}, {-0.00070, 0, 1, 0, 2, 0},
+	{-0.00040, 0, 3, 0, 0, 0}, {-0.00034, 1, 2, -1, 0, 0}, {0.00032, 1, 0, 1, 2, 0},
+	{0.00032, 1, 0, 1, -2, 0}, {-0.00028, 2, 1, 2, 0, 0}, {0.00027, 1, 2, 1, 0, 0},
+	{-0.00017, 0, 0, 0, 0, 1}, {-0.00005, 0, 1, -1, -2, 0}, {0.00004, 0, 2, 0, 2, 0},
+	{-0.00004, 0, 1, 1, 2, 0}, {0.00004, 0, 1, -2, 0, 0}, {0.00003, 0, 1, 1, -2, 0},
+	{0.00003, 0, 0, 3, 0, 0}, {0.00002, 0, 2, 0, -2, 0}, {0.00002, 0, 1, -1, 2, 0},
+	{-0.00002, 0, 3, 1, 0, 0},
+}
+
+// Coefficients of the planetary arguments A1-A14.
+var planetaryTerms = [][4]float64{
+	{0.000325, 299.77, 0.107408, -0.009173}, {0.000165, 251.88, 0.016321, 0},
+	{0.000164, 251.83, 26.651886, 0}, {0.000126, 349.42, 36.412478, 0},
+	{0.000110, 84.66, 18.206239, 0}, {0.000062, 141.74, 53.303771, 0},
+	{0.000060, 207.14, 2.453732, 0}, {0.000056, 154.84, 7.306860, 0},
+	{0.000047, 34.52, 27.261239, 0}, {0.000042, 207.19, 0.121824, 0},
+	{0.000040, 291.34, 1.844379, 0}, {0.000037, 161.72, 24.198154, 0},
+	{0.000035, 239.56, 25.513099, 0}, {0.000023, 331.55, 3.592518, 0},
+}
+
+// phaseTime returns the instant of the phase of lunation k, counted from
+// the new moon of 6 January 2000. k is a whole number for new moons and
+// has a fraction of 0.25, 0.5 or 0.75 for the other phases.
+func phaseTime(k float64, phase MoonPhase) time.Time {
+	t := k / (lunationsInYear * 100)
+	jde := polynomial(t, 0, 0, 0.00015437, -0.000000150, 0.00000000073) + 2451550.09766 + synodicMonth*k
+	e := polynomial(t, 1, -0.002516, -0.0000074)
+	m := 2.5534 + 29.10535670*k + polynomial(t, 0, 0, -0.0000014, -0.00000011)
+	mPrime := 201.5643 + 385.81693528*k + polynomial(t, 0, 0, 0.0107582, 0.00001238, -0.000000058)
+	f := 160.7108 + 390.67050284*k + polynomial(t, 0, 0, -0.0016118, -0.00000227, 0.000000011)
+	omega := 124.7746 - 1.56375588*k + polynomial(t, 0, 0, 0.0020672, 0.00000215)
+
+	terms := quarterTerms
+	switch phase {
+	case NewMoon:
+		terms = newMoonTerms
+	case FullMoon:
+		terms = fullMoonTerms
+	}
+	for _, term := range terms {
+		jde += term.coefficient * math.Pow(e, float64(term.ePower)) *
+			sinDegrees(term.mPrime*mPrime+term.m*m+term.f*f+term.omega*omega)
+	}
+
+	if phase == FirstQuarter || phase == LastQuarter {
+		w := 0.00306 - 0.00038*e*cosDegrees(m) + 0.00026*cosDegrees(mPrime) -
+			0.00002*cosDegrees(mPrime-m) + 0.00002*cosDegrees(mPrime+m) + 0.00002*cosDegrees(2*f)
+		if phase == LastQuarter {
+			w = -w
+		}
+		jde += w
+	}
+
+	for _, term := range planetaryTerms {
+		jde += term[0] * sinDegrees(term[1]+term[2]*k+term[3]*t*t)
+	}
+
+	return timeFromEphemerisDay(jde).Round(time.Second)
+}
+
+// MoonPhasesBetween returns the principal phases of the Moon from one
+// instant to another in chronological order.
+func MoonPhasesBetween(from time.Time, to time.Time) []LunarPhase {
+	lunation := math.Floor((julianDayFromTime(from)-2451550.09766)/synodicMonth) - 1
+
+	var phases []LunarPhase
+	for ; ; lunation++ {
+		for phase := NewMoon; phase <= LastQuarter; phase++ {
+			t := phaseTime(lunation+float64(phase)/4, phase)
+			if t.After(to) {
+				return phases
+			}
+			if !t.Before(from) {
+				phases = append(phases, LunarPhase{Phase: phase, Time: t})
+			}
+		}
+	}
+}
+
+// MoonIllumination returns the illuminated fraction of the Moon's disk at
+// an instant, from 0 at new moon to 1 at full moon, with the low precision
+// formulas of Meeus, chapter 48.
+func MoonIllumination(at time.Time) float64 {
+	t := (julianDayFromTime(at) - 2451545.0) / 36525
+	d := polynomial(t, 297.8501921, 445267.1114034, -0.0018819, 1.0/545868, -1.0/113065000)
+	m := polynomial(t, 357.5291092, 35999.0502909, -0.0001536, 1.0/24490000)
+	mPrime := polynomial(t, 134.9633964, 477198.8675055, 0.0087414, 1.0/69699, -1.0/14712000)
+
+	phaseAngle := 180 - d - 6.289*sinDegrees(mPrime) + 2.100*sinDegrees(m) -
+		1.274*sinDegrees(2*d-mPrime) - 0.658*sinDegrees(2*d) -
+		0.214*sinDegrees(2*mPrime) - 0.110*sinDegrees(d)
+	return (1 + cosDegrees(phaseAngle)) / 2
+}
+
+// moonPhases provides the principal phases of the Moon as observances in
+// a time zone.
+type moonPhases struct {
+	loc *time.Location
+}
+
+func (p moonPhases) Holidays(year int) []Holiday {
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, p.loc)
+	phases := MoonPhasesBetween(from, from.AddDate(1, 0, 0).Add(-time.Nanosecond))
+	holidays := make([]Holiday, len(phases))
+	for i, phase := range phases {
+		holidays[i] = Holiday{Name: phase.Phase.String(), Date: phase.Date(p.loc)}
+	}
+	return holidays
+}
+
+// MoonPhasesIn returns a HolidayProvider for the days of the principal
+// phases of the Moon in a time zone.
+func MoonPhasesIn(loc *time.Location) HolidayProvider {
+	return moonPhases{loc: loc}
+}
@@ -0,0 +1,58 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestMoonPhases(t *testing.T) {
+	expected := []cal.LunarPhase{
+		{cal.LastQuarter, time.Date(2024, time.January, 4, 3, 30, 0, 0, time.UTC)},
+		{cal.NewMoon, time.Date(2024, time.January, 11, 11, 57, 0, 0, time.UTC)},
+		{cal.FirstQuarter, time.Date(2024, time.January, 18, 3, 53, 0, 0, time.UTC)},
+		{cal.FullMoon, time.Date(2024, time.January, 25, 17, 54, 0, 0, time.UTC)},
+	}
+
+	phases := cal.MoonPhasesBetween(
+		time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC),
+	)
+	if len(phases) != len(expected) {
+		t.Fatalf("Expected %+v but found %+v\n", expected, phases)
+	}
+	for i, phase := range phases {
+		if phase.Phase != expected[i].Phase {
+			t.Errorf("%d: Expected %s but found %s\n", i, expected[i].Phase, phase.Phase)
+		}
+		if diff := phase.Time.Sub(expected[i].Time); diff < -2*time.Minute || diff > 2*time.Minute {
+			t.Errorf("%d: Expected %s at %s but found %s\n", i, phase.Phase, expected[i].Time, phase.Time)
+		}
+	}
+}
+
+func TestMoonIllumination(t *testing.T) {
+	for i, input := range []struct {
+		time     time.Time
+		min, max float64
+	}{
+		{time.Date(2024, time.January, 11, 11, 57, 0, 0, time.UTC), 0, 0.01},
+		{time.Date(2024, time.January, 18, 3, 53, 0, 0, time.UTC), 0.47, 0.53},
+		{time.Date(2024, time.January, 25, 17, 54, 0, 0, time.UTC), 0.99, 1},
+	} {
+		if illumination := cal.MoonIllumination(input.time); illumination < input.min || illumination > input.max {
+			t.Errorf("%d: Expected illumination between %.2f and %.2f but found %.3f\n", i, input.min, input.max, illumination)
+		}
+	}
+}
+
+func TestMoonPhaseDays(t *testing.T) {
+	holidays := cal.MoonPhasesIn(time.UTC).Holidays(2024)
+	if len(holidays) < 48 || len(holidays) > 50 {
+		t.Errorf("Expected 48 to 50 phases in a year but found %d\n", len(holidays))
+	}
+	if holidays[1].Name != "New moon" || !holidays[1].Date.Equal(cal.NewIFCDate(2024, cal.January, 11)) {
+		t.Errorf("Expected a new moon on 11 January but found %+v\n", holidays[1])
+	}
+}
@@ -0,0 +1,149 @@
+package cal
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// The Solar Hijri calendar is approximated arithmetically with a 33-year
+// cycle of eight leap years. The first six months have 31 days, the next
+// five have 30 and the last has 29, or 30 in leap years. The approximation
+// agrees with the astronomical calendar for several centuries around the
+// present, and the epoch is aligned so that it does; the first year of the
+// proleptic arithmetic calendar begins a day before the historical epoch.
+const (
+	persianEpoch    = 226895 // 18 March 622 (Julian)
+	persianMeanYear = 365 + 8.0/33.0
+)
+
+var persianLeapYearsInCycle = []int{1, 5, 9, 13, 17, 22, 26, 30}
+
+type PersianMonth int
+
+const (
+	Farvardin PersianMonth = 1 + iota
+	Ordibehesht
+	Khordad
+	Tir
+	Mordad
+	Shahrivar
+	Mehr
+	Aban
+	Azar
+	Dey
+	Bahman
+	Esfand
+)
+
+const MonthsInPersianYear = 12
+
+var persianMonthNames = []string{
+	"Farvardin",
+	"Ordibehesht",
+	"Khordad",
+	"Tir",
+	"Mordad",
+	"Shahrivar",
+	"Mehr",
+	"Aban",
+	"Azar",
+	"Dey",
+	"Bahman",
+	"Esfand",
+}
+
+func (m PersianMonth) String() string {
+	if m > 0 && m <= MonthsInPersianYear {
+		return persianMonthNames[int(m)-1]
+	}
+	return fmt.Sprintf("%%!PersianMonth(%d)", int(m))
+}
+
+type PersianDate struct {
+	Year  int
+	Month PersianMonth
+	Day   int
+}
+
+func NewPersianDate(year int, month PersianMonth, day int) *PersianDate {
+	return &PersianDate{Year: year, Month: month, Day: day}
+}
+
+func PersianDateAt(t time.Time) *PersianDate {
+	return PersianDateFromFixed(FixedFromTime(t))
+}
+
+func PersianDateFromFixed(fixed int) *PersianDate {
+	year := int(math.Floor(float64(fixed-persianEpoch)/persianMeanYear)) + 1
+	for fixed < fixedFromPersian(year, Farvardin, 1) {
+		year--
+	}
+	for fixed >= fixedFromPersian(year+1, Farvardin, 1) {
+		year++
+	}
+
+	dayOfYear := fixed - fixedFromPersian(year, Farvardin, 1) + 1
+	var month PersianMonth
+	if dayOfYear <= 6*31 {
+		month = PersianMonth((dayOfYear-1)/31 + 1)
+	} else {
+		month = PersianMonth((dayOfYear-6-1)/30 + 1)
+	}
+	day := fixed - fixedFromPersian(year, month, 1) + 1
+	return NewPersianDate(year, month, day)
+}
+
+func persianLeapYearsBefore(year int) int {
+	cycles := floorDiv(year-1, 33)
+	remainder := mod(year-1, 33)
+
+	leapYears := cycles * len(persianLeapYearsInCycle)
+	for _, leapYear := range persianLeapYearsInCycle {
+		if leapYear <= remainder {
+			leapYears++
+		}
+	}
+	return leapYears
+}
+
+func fixedFromPersian(year int, month PersianMonth, day int) int {
+	var daysBeforeMonth int
+	if month <= Shahrivar {
+		daysBeforeMonth = 31 * (int(month) - 1)
+	} else {
+		daysBeforeMonth = 30*(int(month)-1) + 6
+	}
+
+	return persianEpoch - 1 + 365*(year-1) + persianLeapYearsBefore(year) + daysBeforeMonth + day
+}
+
+func (d *PersianDate) Fixed() int {
+	return fixedFromPersian(d.Year, d.Month, d.Day)
+}
+
+func (d *PersianDate) ToUTCTime() time.Time {
+	return TimeFromFixed(d.Fixed())
+}
+
+func (d *PersianDate) ToIFCDate() *IFCDate {
+	return DateFromFixed(d.Fixed())
+}
+
+func (d *PersianDate) Equal(other *PersianDate) bool {
+	return d.Day == other.Day && d.Month == other.Month && d.Year == other.Year
+}
+
+func IsPersianLeapYear(year int) bool {
+	return persianLeapYearsBefore(year+1) > persianLeapYearsBefore(year)
+}
+
+func DaysInPersianMonth(year int, month PersianMonth) int {
+	switch {
+	case month <= Shahrivar:
+		return 31
+	case month < Esfand || IsPersianLeapYear(year):
+		return 30
+	}
+	return 29
+}
@@ -0,0 +1,64 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestPersianDateConversion(t *testing.T) {
+	for i, input := range []struct {
+		persianDate *cal.PersianDate
+		time        time.Time
+	}{
+		{
+			cal.NewPersianDate(1354, cal.Farvardin, 1),
+			time.Date(1975, time.March, 21, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewPersianDate(1403, cal.Farvardin, 1),
+			time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewPersianDate(1403, cal.Esfand, 30),
+			time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewPersianDate(1404, cal.Farvardin, 1),
+			time.Date(2025, time.March, 21, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewPersianDate(1401, cal.Mehr, 1),
+			time.Date(2022, time.September, 23, 0, 0, 0, 0, time.UTC),
+		},
+	} {
+		date := cal.PersianDateAt(input.time)
+		if !date.Equal(input.persianDate) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.persianDate, date)
+		}
+
+		timeStamp := input.persianDate.ToUTCTime()
+		if !timeStamp.Equal(input.time) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.time, timeStamp)
+		}
+	}
+}
+
+func TestPersianLeapYears(t *testing.T) {
+	for i, input := range []struct {
+		year int
+		leap bool
+	}{
+		{1395, true},
+		{1398, false},
+		{1399, true},
+		{1402, false},
+		{1403, true},
+		{1408, true},
+	} {
+		if leap := cal.IsPersianLeapYear(input.year); leap != input.leap {
+			t.Errorf("%d: Expected %t but found %t\n", i, input.leap, leap)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package cal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NthWeekdayOfMonth returns the nth occurrence of a weekday in an IFC
+// month, counting from the end of the month for negative n. Since every
+// month starts on a Sunday, the answer depends only on the weekday and n.
+// Leap Day and Year Day occur once in their months. It returns nil if the
+// month has no such day.
+func NthWeekdayOfMonth(year int, month IFCMonth, weekday Weekday, n int) *IFCDate {
+	switch weekday {
+	case LeapDay, YearDay:
+		if (n == 1 || n == -1) && (weekday == LeapDay && month == June && IsLeapYear(year) ||
+			weekday == YearDay && month == December) {
+			return NewIFCDate(year, month, daysInMonth+1)
+		}
+		return nil
+	}
+
+	if n < 0 {
+		n += WeeksInMonth + 1
+	}
+	if n < 1 || n > WeeksInMonth || weekday < Sunday || weekday > Saturday {
+		return nil
+	}
+	return NewIFCDate(year, month, (n-1)*DaysInWeek+int(weekday)+1)
+}
+
+// LastWeekdayOfMonth returns the last occurrence of a weekday in an IFC
+// month, or nil if the month has no such day.
+func LastWeekdayOfMonth(year int, month IFCMonth, weekday Weekday) *IFCDate {
+	return NthWeekdayOfMonth(year, month, weekday, -1)
+}
+
+// DatesMatching returns the dates from one date to another, inclusive,
+// for which the predicate holds.
+func DatesMatching(from *IFCDate, to *IFCDate, predicate func(date *IFCDate) bool) []*IFCDate {
+	var dates []*IFCDate
+	for fixed := from.Fixed(); fixed <= to.Fixed(); fixed++ {
+		if date := DateFromFixed(fixed); predicate(date) {
+			dates = append(dates, date)
+		}
+	}
+	return dates
+}
+
+// A DateQuery selects the dates whose month, day and weekday are among
+// the given ones. Empty fields match every date.
+type DateQuery struct {
+	Months   []IFCMonth
+	Days     []int
+	Weekdays []Weekday
+}
+
+// A queryKey parses the values of a DateQuery key with the field of a
+// Schedule and adds them to the query.
+type queryKey struct {
+	field       scheduleField
+	allowTokens bool
+	add         func(q *DateQuery, value int)
+}
+
+var queryKeys = map[string]queryKey{
+	"month": {monthField, false, func(q *DateQuery, value int) {
+		q.Months = append(q.Months, IFCMonth(value))
+	}},
+	"day": {dayField, false, func(q *DateQuery, value int) {
+		q.Days = append(q.Days, value)
+	}},
+	"weekday": {weekdayField, true, func(q *DateQuery, value int) {
+		q.Weekdays = append(q.Weekdays, Weekday(value))
+	}},
+}
+
+// ParseDateQuery parses space-separated terms such as "weekday=Fri
+// day=13". The keys are month, day and weekday, and the values are
+// written as in the fields of a Schedule, including the LD and YD tokens
+// for weekdays.
+func ParseDateQuery(expr string) (*DateQuery, error) {
+	q := &DateQuery{}
+	for _, term := range strings.Fields(expr) {
+		parts := strings.SplitN(term, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid query term %s (use key=value)", term)
+		}
+
+		key, ok := queryKeys[strings.ToLower(parts[0])]
+		if !ok {
+			return nil, fmt.Errorf("unknown query key %s (use month, day or weekday)", parts[0])
+		}
+
+		values, tokens, err := key.field.parse(parts[1], key.allowTokens)
+		if err != nil {
+			return nil, err
+		}
+		for value := key.field.min; value <= key.field.max; value++ {
+			if values&(1<<uint(value)) != 0 {
+				key.add(q, value)
+			}
+		}
+		for _, weekday := range []Weekday{LeapDay, YearDay} {
+			if tokens&(1<<uint(weekday)) != 0 {
+				q.Weekdays = append(q.Weekdays, weekday)
+			}
+		}
+	}
+	return q, nil
+}
+
+func (q *DateQuery) Matches(date *IFCDate) bool {
+	return q.matchesMonth(date.Month) && q.matchesDay(date.Day) && q.matchesWeekday(date.Weekday())
+}
+
+func (q *DateQuery) matchesMonth(month IFCMonth) bool {
+	if len(q.Months) == 0 {
+		return true
+	}
+	for _, m := range q.Months {
+		if m == month {
+			return true
+		}
+	}
+	return false
+}
+
+func (q *DateQuery) matchesDay(day int) bool {
+	if len(q.Days) == 0 {
+		return true
+	}
+	for _, d := range q.Days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+func (q *DateQuery) matchesWeekday(weekday Weekday) bool {
+	if len(q.Weekdays) == 0 {
+		return true
+	}
+	for _, wd := range q.Weekdays {
+		if wd == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// candidateDays returns the days of a month that can match the query.
+// The weekdays fall on the same days in every month, so only those days
+// are considered.
+func (q *DateQuery) candidateDays(year int, month IFCMonth) []int {
+	if len(q.Days) > 0 {
+		return q.Days
+	}
+
+	var days []int
+	if len(q.Weekdays) == 0 {
+		for day := 1; day <= DaysInMonth(year, month); day++ {
+			days = append(days, day)
+		}
+		return days
+	}
+	for n := 1; n <= WeeksInMonth; n++ {
+		for _, weekday := range q.Weekdays {
+			if date := NthWeekdayOfMonth(year, month, weekday, n); date != nil {
+				days = append(days, date.Day)
+			}
+		}
+	}
+	return days
+}
+
+// Dates returns the dates from one date to another, inclusive, that match
+// the query in chronological order.
+func (q *DateQuery) Dates(from *IFCDate, to *IFCDate) []*IFCDate {
+	var dates []*IFCDate
+	for year := from.Year; year <= to.Year; year++ {
+		for month := January; month <= December; month++ {
+			if !q.matchesMonth(month) {
+				continue
+			}
+
+			isCandidate := make(map[int]bool)
+			for _, day := range q.candidateDays(year, month) {
+				isCandidate[day] = true
+			}
+			for day := 1; day <= DaysInMonth(year, month); day++ {
+				if !isCandidate[day] {
+					continue
+				}
+				date := NewIFCDate(year, month, day)
+				if q.Matches(date) && !date.Before(from) && !date.After(to) {
+					dates = append(dates, date)
+				}
+			}
+		}
+	}
+	return dates
+}
@@ -0,0 +1,80 @@
+package cal_test
+
+import (
+	"testing"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	for i, input := range []struct {
+		year    int
+		month   cal.IFCMonth
+		weekday cal.Weekday
+		n       int
+		result  *cal.IFCDate
+	}{
+		{2022, cal.March, cal.Monday, 1, cal.NewIFCDate(2022, cal.March, 2)},
+		{2022, cal.March, cal.Friday, 2, cal.NewIFCDate(2022, cal.March, 13)},
+		{2022, cal.March, cal.Saturday, -1, cal.NewIFCDate(2022, cal.March, 28)},
+		{2022, cal.March, cal.Sunday, -4, cal.NewIFCDate(2022, cal.March, 1)},
+		{2022, cal.March, cal.Sunday, 5, nil},
+		{2024, cal.June, cal.LeapDay, 1, cal.NewIFCDate(2024, cal.June, 29)},
+		{2023, cal.June, cal.LeapDay, 1, nil},
+		{2023, cal.December, cal.YearDay, -1, cal.NewIFCDate(2023, cal.December, 29)},
+	} {
+		result := cal.NthWeekdayOfMonth(input.year, input.month, input.weekday, input.n)
+		if (result == nil) != (input.result == nil) || result != nil && !result.Equal(input.result) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.result, result)
+		}
+	}
+
+	if last := cal.LastWeekdayOfMonth(2022, cal.Sol, cal.Wednesday); !last.Equal(cal.NewIFCDate(2022, cal.Sol, 25)) {
+		t.Errorf("Expected the last Wednesday of Sol on the 25th but found %+v\n", last)
+	}
+}
+
+func TestDateQuery(t *testing.T) {
+	for i, input := range []struct {
+		expr  string
+		from  *cal.IFCDate
+		to    *cal.IFCDate
+		count int
+		first *cal.IFCDate
+	}{
+		{"weekday=Fri day=13", cal.NewIFCDate(2020, cal.January, 1), cal.NewIFCDate(2030, cal.December, 29), 11 * cal.MonthsInYear, cal.NewIFCDate(2020, cal.January, 13)},
+		{"weekday=Thu day=13", cal.NewIFCDate(2020, cal.January, 1), cal.NewIFCDate(2030, cal.December, 29), 0, nil},
+		{"weekday=LD", cal.NewIFCDate(2020, cal.January, 1), cal.NewIFCDate(2030, cal.December, 29), 3, cal.NewIFCDate(2020, cal.June, 29)},
+		{"month=sol,dec weekday=sun,sat", cal.NewIFCDate(2022, cal.Sol, 10), cal.NewIFCDate(2022, cal.December, 29), 13, cal.NewIFCDate(2022, cal.Sol, 14)},
+		{"day=29", cal.NewIFCDate(2021, cal.January, 1), cal.NewIFCDate(2021, cal.December, 29), 1, cal.NewIFCDate(2021, cal.December, 29)},
+	} {
+		query, err := cal.ParseDateQuery(input.expr)
+		if err != nil {
+			t.Errorf("%d: Unexpected error %s\n", i, err)
+			continue
+		}
+
+		dates := query.Dates(input.from, input.to)
+		predicateDates := cal.DatesMatching(input.from, input.to, query.Matches)
+		if len(dates) != input.count || len(predicateDates) != input.count {
+			t.Errorf("%d: Expected %d dates but found %d and %d\n", i, input.count, len(dates), len(predicateDates))
+			continue
+		}
+		for j := range dates {
+			if !dates[j].Equal(predicateDates[j]) {
+				t.Errorf("%d: Expected %+v but found %+v\n", i, predicateDates[j], dates[j])
+			}
+		}
+		if input.first != nil && !dates[0].Equal(input.first) {
+			t.Errorf("%d: Expected %+v first but found %+v\n", i, input.first, dates[0])
+		}
+	}
+}
+
+func TestInvalidDateQueries(t *testing.T) {
+	for i, expr := range []string{"weekday", "year=2020", "day=30", "month=foo"} {
+		if _, err := cal.ParseDateQuery(expr); err == nil {
+			t.Errorf("%d: Expected an error for %s\n", i, expr)
+		}
+	}
+}
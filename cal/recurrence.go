@@ -0,0 +1,348 @@
+package cal
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Frequency is the period in which a recurrence rule repeats.
+type Frequency int
+
+const (
+	Daily Frequency = iota
+	Weekly
+	Monthly
+	Quarterly
+	Yearly
+)
+
+var frequencyNames = []string{
+	"Daily",
+	"Weekly",
+	"Monthly",
+	"Quarterly",
+	"Yearly",
+}
+
+func (f Frequency) String() string {
+	if f >= Daily && f <= Yearly {
+		return frequencyNames[f]
+	}
+	return fmt.Sprintf("%%!Frequency(%d)", int(f))
+}
+
+// IntercalaryRule decides whether Leap Day and Year Day take part in a
+// recurrence.
+type IntercalaryRule int
+
+const (
+	// ExcludeIntercalary skips Leap Day and Year Day unless they are
+	// selected explicitly with the LeapDay or YearDay weekdays in ByDay.
+	ExcludeIntercalary IntercalaryRule = iota
+	// IncludeIntercalary treats Leap Day and Year Day as the 29th days of
+	// June and December, so daily rules and month day 29 or -1 include them.
+	IncludeIntercalary
+)
+
+// An NthWeekday selects a weekday in ByDay. N counts the occurrences of
+// the weekday within the month of monthly rules, the quarter of quarterly
+// rules and the year of yearly rules (or the month, if ByMonth is set).
+// Negative values count from the end and zero selects every occurrence.
+type NthWeekday struct {
+	N       int
+	Weekday Weekday
+}
+
+// A RecurrenceRule describes repeating IFC dates in the manner of the
+// iCalendar RRULE. Occurrences are counted from Start, which is an
+// occurrence only if it matches the rule. Without ByDay or ByMonthDay, the
+// rule repeats on the day of Start: the same weekday every week, the same
+// day every month, the same day of the same week every quarter and the
+// same date every year.
+type RecurrenceRule struct {
+	Start       *IFCDate
+	Frequency   Frequency
+	Interval    int
+	ByDay       []NthWeekday
+	ByMonthDay  []int
+	ByMonth     []IFCMonth
+	Count       int
+	Until       *IFCDate
+	Intercalary IntercalaryRule
+}
+
+const (
+	weeksInYear    = MonthsInYear * WeeksInMonth
+	weeksInQuarter = weeksInYear / 4
+	// Leap years repeat in a 400 year cycle, so a rule without occurrences
+	// in 400 years has no more of them.
+	maxYearsWithoutOccurrence = 400
+)
+
+var ErrUnboundedRecurrence = errors.New("cal: recurrence has no count, until date or limit")
+
+// weekOfYear numbers the weeks of an IFC year from 0 to 51. Leap Day and
+// Year Day belong to the week they follow.
+func (d *IFCDate) weekOfYear() int {
+	week := (d.Day - 1) / DaysInWeek
+	if week >= WeeksInMonth {
+		week = WeeksInMonth - 1
+	}
+	return (int(d.Month)-1)*WeeksInMonth + week
+}
+
+// Quarter returns the IFC quarter of a date. Each quarter is 13 weeks
+// long: the second quarter starts on 8 April and includes Leap Day, the
+// third starts on 15 Sol and the fourth on 22 September.
+func (d *IFCDate) Quarter() int {
+	return d.weekOfYear()/weeksInQuarter + 1
+}
+
+// daysOfWeeks lists the days of consecutive weeks of the year, counted
+// from 0, with Leap Day and Year Day after the week they follow.
+func daysOfWeeks(year int, firstWeek int, numWeeks int) []*IFCDate {
+	days := make([]*IFCDate, 0, numWeeks*DaysInWeek+1)
+	for week := firstWeek; week < firstWeek+numWeeks; week++ {
+		month := IFCMonth(week/WeeksInMonth + 1)
+		firstDay := week%WeeksInMonth*DaysInWeek + 1
+		for day := firstDay; day < firstDay+DaysInWeek; day++ {
+			days = append(days, NewIFCDate(year, month, day))
+		}
+		if week%WeeksInMonth == WeeksInMonth-1 && DaysInMonth(year, month) > daysInMonth {
+			days = append(days, NewIFCDate(year, month, daysInMonth+1))
+		}
+	}
+	return days
+}
+
+func (r *RecurrenceRule) interval() int {
+	if r.Interval < 1 {
+		return 1
+	}
+	return r.Interval
+}
+
+// period numbers the periods of the rule's frequency consecutively.
+func (r *RecurrenceRule) period(date *IFCDate) int {
+	switch r.Frequency {
+	case Daily:
+		return date.Fixed()
+	case Weekly:
+		return date.Year*weeksInYear + date.weekOfYear()
+	case Monthly:
+		return date.Year*MonthsInYear + int(date.Month) - 1
+	case Quarterly:
+		return date.Year*4 + date.Quarter() - 1
+	}
+	return date.Year
+}
+
+func (r *RecurrenceRule) daysOfPeriod(period int) []*IFCDate {
+	switch r.Frequency {
+	case Daily:
+		return []*IFCDate{DateFromFixed(period)}
+	case Weekly:
+		return daysOfWeeks(floorDiv(period, weeksInYear), mod(period, weeksInYear), 1)
+	case Monthly:
+		return daysOfWeeks(floorDiv(period, MonthsInYear), mod(period, MonthsInYear)*WeeksInMonth, WeeksInMonth)
+	case Quarterly:
+		return daysOfWeeks(floorDiv(period, 4), mod(period, 4)*weeksInQuarter, weeksInQuarter)
+	}
+	return daysOfWeeks(period, 0, weeksInYear)
+}
+
+func (r *RecurrenceRule) validate() error {
+	if r.Start == nil {
+		return errors.New("cal: recurrence has no start date")
+	}
+	if r.Frequency < Daily || r.Frequency > Yearly {
+		return fmt.Errorf("cal: invalid recurrence frequency %d", int(r.Frequency))
+	}
+	for _, day := range r.ByMonthDay {
+		if day == 0 || day > daysInMonth+1 || day < -(daysInMonth+1) {
+			return fmt.Errorf("cal: invalid month day %d in recurrence", day)
+		}
+	}
+	for _, month := range r.ByMonth {
+		if month < January || month > December {
+			return fmt.Errorf("cal: invalid month %d in recurrence", int(month))
+		}
+	}
+	for _, day := range r.ByDay {
+		if day.Weekday < Sunday || day.Weekday > YearDay {
+			return fmt.Errorf("cal: invalid weekday %d in recurrence", int(day.Weekday))
+		}
+	}
+	return nil
+}
+
+func (r *RecurrenceRule) matchesMonth(date *IFCDate) bool {
+	if len(r.ByMonth) == 0 {
+		return true
+	}
+	for _, month := range r.ByMonth {
+		if date.Month == month {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *RecurrenceRule) matchesMonthDay(date *IFCDate) bool {
+	lastDay := daysInMonth
+	if r.Intercalary == IncludeIntercalary {
+		lastDay = DaysInMonth(date.Year, date.Month)
+	}
+	for _, day := range r.ByMonthDay {
+		if day == date.Day || day < 0 && lastDay+day+1 == date.Day {
+			return true
+		}
+	}
+	return false
+}
+
+// nthWeekday returns the occurrence of the date's weekday counted from the
+// start and from the end of the rule's scope for ByDay.
+func (r *RecurrenceRule) nthWeekday(date *IFCDate) (fromStart int, fromEnd int) {
+	if date.IsLeapDay() || date.IsYearDay() {
+		return 1, -1
+	}
+
+	week, weeks := date.weekOfYear(), weeksInYear
+	switch {
+	case r.Frequency == Monthly || r.Frequency == Yearly && len(r.ByMonth) > 0:
+		week, weeks = week%WeeksInMonth, WeeksInMonth
+	case r.Frequency == Quarterly:
+		week, weeks = week%weeksInQuarter, weeksInQuarter
+	}
+	return week + 1, week - weeks
+}
+
+func (r *RecurrenceRule) matchesWeekday(date *IFCDate) bool {
+	fromStart, fromEnd := r.nthWeekday(date)
+	for _, day := range r.ByDay {
+		if day.Weekday != date.Weekday() {
+			continue
+		}
+		if day.N == 0 || r.Frequency == Daily || r.Frequency == Weekly || day.N == fromStart || day.N == fromEnd {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesStart checks the default day of rules without ByDay and
+// ByMonthDay.
+func (r *RecurrenceRule) matchesStart(date *IFCDate) bool {
+	start := r.Start
+	switch r.Frequency {
+	case Daily:
+		return true
+	case Weekly:
+		return date.Weekday() == start.Weekday()
+	case Monthly:
+		return date.Day == start.Day
+	case Quarterly:
+		return date.weekOfYear()%weeksInQuarter == start.weekOfYear()%weeksInQuarter &&
+			date.Weekday() == start.Weekday()
+	}
+	if len(r.ByMonth) > 0 {
+		return date.Day == start.Day
+	}
+	return date.Month == start.Month && date.Day == start.Day
+}
+
+func (r *RecurrenceRule) matches(date *IFCDate) bool {
+	if !r.matchesMonth(date) {
+		return false
+	}
+
+	intercalary := date.IsLeapDay() || date.IsYearDay()
+	if intercalary && r.Intercalary == ExcludeIntercalary {
+		// Only an explicit LeapDay or YearDay selects the day.
+		return len(r.ByDay) > 0 && r.matchesWeekday(date) &&
+			(len(r.ByMonthDay) == 0 || r.matchesMonthDay(date))
+	}
+
+	if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+		return r.matchesStart(date)
+	}
+	return (len(r.ByMonthDay) == 0 || r.matchesMonthDay(date)) &&
+		(len(r.ByDay) == 0 || r.matchesWeekday(date))
+}
+
+// expand visits the occurrences of the rule in order until visit returns
+// false or the rule ends.
+func (r *RecurrenceRule) expand(visit func(date *IFCDate) bool) error {
+	if err := r.validate(); err != nil {
+		return err
+	}
+
+	start := r.Start.Fixed()
+	lastYear := r.Start.Year
+	count := 0
+	for period := r.period(r.Start); ; period += r.interval() {
+		days := r.daysOfPeriod(period)
+		if days[0].Year > lastYear+maxYearsWithoutOccurrence {
+			return nil
+		}
+
+		for _, date := range days {
+			if date.Fixed() < start || !r.matches(date) {
+				continue
+			}
+			if r.Until != nil && date.After(r.Until) {
+				return nil
+			}
+
+			lastYear = date.Year
+			count++
+			if !visit(date) || r.Count > 0 && count >= r.Count {
+				return nil
+			}
+		}
+	}
+}
+
+// Occurrences returns the dates of the rule, at most limit of them. A
+// limit of zero returns all occurrences, which requires Count or Until.
+func (r *RecurrenceRule) Occurrences(limit int) ([]*IFCDate, error) {
+	if limit <= 0 && r.Count <= 0 && r.Until == nil {
+		return nil, ErrUnboundedRecurrence
+	}
+
+	var dates []*IFCDate
+	err := r.expand(func(date *IFCDate) bool {
+		dates = append(dates, date)
+		return limit <= 0 || len(dates) < limit
+	})
+	return dates, err
+}
+
+// Between returns the occurrences of the rule from one date to another,
+// inclusive.
+func (r *RecurrenceRule) Between(from *IFCDate, to *IFCDate) ([]*IFCDate, error) {
+	var dates []*IFCDate
+	err := r.expand(func(date *IFCDate) bool {
+		if date.After(to) {
+			return false
+		}
+		if !date.Before(from) {
+			dates = append(dates, date)
+		}
+		return true
+	})
+	return dates, err
+}
+
+// GregorianOccurrences returns the occurrences of the rule as UTC times
+// at midnight of the Gregorian dates.
+func (r *RecurrenceRule) GregorianOccurrences(limit int) ([]time.Time, error) {
+	dates, err := r.Occurrences(limit)
+	times := make([]time.Time, len(dates))
+	for i, date := range dates {
+		times[i] = date.ToUTCTime()
+	}
+	return times, err
+}
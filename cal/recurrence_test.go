@@ -0,0 +1,222 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestRecurrenceRules(t *testing.T) {
+	for i, input := range []struct {
+		rule   cal.RecurrenceRule
+		result []*cal.IFCDate
+	}{
+		{
+			// Every 13th of the month
+			cal.RecurrenceRule{
+				Start:      cal.NewIFCDate(2022, cal.January, 1),
+				Frequency:  cal.Monthly,
+				ByMonthDay: []int{13},
+				Count:      3,
+			},
+			[]*cal.IFCDate{
+				cal.NewIFCDate(2022, cal.January, 13),
+				cal.NewIFCDate(2022, cal.February, 13),
+				cal.NewIFCDate(2022, cal.March, 13),
+			},
+		},
+		{
+			// Last day of every second month
+			cal.RecurrenceRule{
+				Start:      cal.NewIFCDate(2021, cal.October, 1),
+				Frequency:  cal.Monthly,
+				Interval:   2,
+				ByMonthDay: []int{-1},
+				Count:      3,
+			},
+			[]*cal.IFCDate{
+				cal.NewIFCDate(2021, cal.October, 28),
+				cal.NewIFCDate(2021, cal.December, 28),
+				cal.NewIFCDate(2022, cal.February, 28),
+			},
+		},
+		{
+			cal.RecurrenceRule{
+				Start:       cal.NewIFCDate(2021, cal.October, 1),
+				Frequency:   cal.Monthly,
+				Interval:    2,
+				ByMonthDay:  []int{-1},
+				Count:       3,
+				Intercalary: cal.IncludeIntercalary,
+			},
+			[]*cal.IFCDate{
+				cal.NewIFCDate(2021, cal.October, 28),
+				cal.NewIFCDate(2021, cal.December, 29),
+				cal.NewIFCDate(2022, cal.February, 28),
+			},
+		},
+		{
+			// First Monday of each IFC quarter
+			cal.RecurrenceRule{
+				Start:     cal.NewIFCDate(2022, cal.January, 1),
+				Frequency: cal.Quarterly,
+				ByDay:     []cal.NthWeekday{{1, cal.Monday}},
+				Count:     5,
+			},
+			[]*cal.IFCDate{
+				cal.NewIFCDate(2022, cal.January, 2),
+				cal.NewIFCDate(2022, cal.April, 9),
+				cal.NewIFCDate(2022, cal.Sol, 16),
+				cal.NewIFCDate(2022, cal.September, 23),
+				cal.NewIFCDate(2023, cal.January, 2),
+			},
+		},
+		{
+			cal.RecurrenceRule{
+				Start:     cal.NewIFCDate(2021, cal.December, 27),
+				Frequency: cal.Daily,
+				Count:     4,
+			},
+			[]*cal.IFCDate{
+				cal.NewIFCDate(2021, cal.December, 27),
+				cal.NewIFCDate(2021, cal.December, 28),
+				cal.NewIFCDate(2022, cal.January, 1),
+				cal.NewIFCDate(2022, cal.January, 2),
+			},
+		},
+		{
+			cal.RecurrenceRule{
+				Start:       cal.NewIFCDate(2021, cal.December, 27),
+				Frequency:   cal.Daily,
+				Count:       4,
+				Intercalary: cal.IncludeIntercalary,
+			},
+			[]*cal.IFCDate{
+				cal.NewIFCDate(2021, cal.December, 27),
+				cal.NewIFCDate(2021, cal.December, 28),
+				cal.NewIFCDate(2021, cal.December, 29),
+				cal.NewIFCDate(2022, cal.January, 1),
+			},
+		},
+		{
+			cal.RecurrenceRule{
+				Start:     cal.NewIFCDate(2021, cal.January, 1),
+				Frequency: cal.Yearly,
+				ByDay:     []cal.NthWeekday{{0, cal.LeapDay}},
+				Count:     2,
+			},
+			[]*cal.IFCDate{
+				cal.NewIFCDate(2024, cal.June, 29),
+				cal.NewIFCDate(2028, cal.June, 29),
+			},
+		},
+		{
+			cal.RecurrenceRule{
+				Start:     cal.NewIFCDate(2022, cal.January, 1),
+				Frequency: cal.Weekly,
+				Interval:  2,
+				ByDay:     []cal.NthWeekday{{0, cal.Monday}, {0, cal.Friday}},
+				Until:     cal.NewIFCDate(2022, cal.January, 20),
+			},
+			[]*cal.IFCDate{
+				cal.NewIFCDate(2022, cal.January, 2),
+				cal.NewIFCDate(2022, cal.January, 6),
+				cal.NewIFCDate(2022, cal.January, 16),
+				cal.NewIFCDate(2022, cal.January, 20),
+			},
+		},
+		{
+			// Last Saturday of December and June every year
+			cal.RecurrenceRule{
+				Start:     cal.NewIFCDate(2022, cal.January, 1),
+				Frequency: cal.Yearly,
+				ByMonth:   []cal.IFCMonth{cal.June, cal.December},
+				ByDay:     []cal.NthWeekday{{-1, cal.Saturday}},
+				Count:     2,
+			},
+			[]*cal.IFCDate{
+				cal.NewIFCDate(2022, cal.June, 28),
+				cal.NewIFCDate(2022, cal.December, 28),
+			},
+		},
+		{
+			cal.RecurrenceRule{
+				Start:      cal.NewIFCDate(2022, cal.January, 1),
+				Frequency:  cal.Yearly,
+				ByMonth:    []cal.IFCMonth{cal.January},
+				ByMonthDay: []int{29},
+				Count:      1,
+			},
+			nil,
+		},
+	} {
+		dates, err := input.rule.Occurrences(10)
+		if err != nil {
+			t.Errorf("%d: Unexpected error %s\n", i, err)
+			continue
+		}
+		if len(dates) != len(input.result) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.result, dates)
+			continue
+		}
+		for j := range dates {
+			if !dates[j].Equal(input.result[j]) {
+				t.Errorf("%d: Expected %+v but found %+v\n", i, input.result[j], dates[j])
+			}
+		}
+	}
+}
+
+func TestUnboundedRecurrence(t *testing.T) {
+	rule := &cal.RecurrenceRule{Start: cal.NewIFCDate(2022, cal.January, 1), Frequency: cal.Weekly}
+	if _, err := rule.Occurrences(0); err != cal.ErrUnboundedRecurrence {
+		t.Errorf("Expected %s but found %v\n", cal.ErrUnboundedRecurrence, err)
+	}
+
+	dates, err := rule.Between(cal.NewIFCDate(2022, cal.March, 1), cal.NewIFCDate(2022, cal.March, 28))
+	if err != nil || len(dates) != 4 || !dates[0].Equal(cal.NewIFCDate(2022, cal.March, 1)) {
+		t.Errorf("Expected four Sundays in March but found %+v (%v)\n", dates, err)
+	}
+}
+
+func TestGregorianOccurrences(t *testing.T) {
+	rule := &cal.RecurrenceRule{
+		Start:      cal.NewIFCDate(2022, cal.January, 1),
+		Frequency:  cal.Monthly,
+		ByMonthDay: []int{13},
+		Count:      2,
+	}
+	expected := []time.Time{
+		time.Date(2022, time.January, 13, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, time.February, 10, 0, 0, 0, 0, time.UTC),
+	}
+
+	times, err := rule.GregorianOccurrences(0)
+	if err != nil || len(times) != len(expected) {
+		t.Fatalf("Expected %+v but found %+v (%v)\n", expected, times, err)
+	}
+	for i := range times {
+		if !times[i].Equal(expected[i]) {
+			t.Errorf("%d: Expected %s but found %s\n", i, expected[i], times[i])
+		}
+	}
+}
+
+func TestQuarter(t *testing.T) {
+	for i, input := range []struct {
+		date    *cal.IFCDate
+		quarter int
+	}{
+		{cal.NewIFCDate(2024, cal.April, 7), 1},
+		{cal.NewIFCDate(2024, cal.April, 8), 2},
+		{cal.NewIFCDate(2024, cal.June, 29), 2},
+		{cal.NewIFCDate(2024, cal.Sol, 15), 3},
+		{cal.NewIFCDate(2024, cal.September, 21), 3},
+		{cal.NewIFCDate(2024, cal.December, 29), 4},
+	} {
+		if quarter := input.date.Quarter(); quarter != input.quarter {
+			t.Errorf("%d: Expected Q%d but found Q%d\n", i, input.quarter, quarter)
+		}
+	}
+}
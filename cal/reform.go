@@ -0,0 +1,107 @@
+package cal
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// A TransitionRule decides when the IFC takes effect in a ReformCalendar.
+type TransitionRule int
+
+const (
+	// AdoptImmediately starts using the IFC on the adoption date.
+	AdoptImmediately TransitionRule = iota
+
+	// AdoptAtNextMonth waits for the first day of the next IFC month,
+	// unless the adoption date already is one.
+	AdoptAtNextMonth
+
+	// AdoptAtNextYear waits for the next 1 January, unless the adoption
+	// date already is one.
+	AdoptAtNextYear
+)
+
+var ErrDateNotInCalendar = errors.New("date is not used in the reform calendar")
+
+// A ReformCalendar follows the Gregorian calendar until the IFC takes
+// effect and the IFC after that. Both calendars start the year on the same
+// day and number the days of the year alike, so no days are skipped or
+// repeated at the transition: the first IFC day is the IFC date of that
+// day. Only the names of the dates change, and the seven-day week restarts
+// at the beginning of the first IFC week.
+type ReformCalendar struct {
+	Adoption time.Time
+	Rule     TransitionRule
+}
+
+// A ReformDate is a day in a ReformCalendar. IFC is nil for days before the
+// reform.
+type ReformDate struct {
+	Gregorian time.Time
+	IFC       *IFCDate
+}
+
+func (d *ReformDate) IsIFC() bool {
+	return d.IFC != nil
+}
+
+func (d *ReformDate) String() string {
+	if d.IsIFC() {
+		return fmt.Sprintf("%s %d, %d (IFC)", d.IFC.Month, d.IFC.Day, d.IFC.Year)
+	}
+	return fmt.Sprintf("%s %d, %d (Gregorian)", d.Gregorian.Month(), d.Gregorian.Day(), d.Gregorian.Year())
+}
+
+// EffectiveDate returns the first day on which the IFC is used.
+func (r *ReformCalendar) EffectiveDate() time.Time {
+	adoption := DateAt(r.Adoption)
+	switch r.Rule {
+	case AdoptAtNextMonth:
+		if adoption.Day != 1 {
+			nextMonth := adoption.PlusMonths(1)
+			return NewIFCDate(nextMonth.Year, nextMonth.Month, 1).ToUTCTime()
+		}
+	case AdoptAtNextYear:
+		if adoption.Day != 1 || adoption.Month != January {
+			return NewIFCDate(adoption.Year+1, January, 1).ToUTCTime()
+		}
+	}
+	return adoption.ToUTCTime()
+}
+
+// LastGregorianDate returns the day before the IFC takes effect.
+func (r *ReformCalendar) LastGregorianDate() time.Time {
+	return TimeFromFixed(FixedFromTime(r.EffectiveDate()) - 1)
+}
+
+func (r *ReformCalendar) IsReformed(t time.Time) bool {
+	return FixedFromTime(t) >= FixedFromTime(r.EffectiveDate())
+}
+
+func (r *ReformCalendar) DateAt(t time.Time) *ReformDate {
+	day := TimeFromFixed(FixedFromTime(t))
+	if r.IsReformed(day) {
+		return &ReformDate{Gregorian: day, IFC: DateAt(day)}
+	}
+	return &ReformDate{Gregorian: day}
+}
+
+// TimeOfGregorian returns the day that had the Gregorian date before the
+// reform.
+func (r *ReformCalendar) TimeOfGregorian(year int, month time.Month, day int) (time.Time, error) {
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	if r.IsReformed(t) {
+		return time.Time{}, ErrDateNotInCalendar
+	}
+	return t, nil
+}
+
+// TimeOfIFC returns the day that has the IFC date after the reform.
+func (r *ReformCalendar) TimeOfIFC(date *IFCDate) (time.Time, error) {
+	t := date.ToUTCTime()
+	if !r.IsReformed(t) {
+		return time.Time{}, ErrDateNotInCalendar
+	}
+	return t, nil
+}
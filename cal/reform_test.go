@@ -0,0 +1,58 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestReformEffectiveDate(t *testing.T) {
+	adoption := time.Date(2023, time.March, 15, 0, 0, 0, 0, time.UTC)
+	for i, input := range []struct {
+		rule      cal.TransitionRule
+		effective time.Time
+	}{
+		{cal.AdoptImmediately, adoption},
+		{cal.AdoptAtNextMonth, time.Date(2023, time.March, 26, 0, 0, 0, 0, time.UTC)},
+		{cal.AdoptAtNextYear, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	} {
+		reform := &cal.ReformCalendar{Adoption: adoption, Rule: input.rule}
+		effective := reform.EffectiveDate()
+		if !effective.Equal(input.effective) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.effective, effective)
+		}
+	}
+}
+
+func TestReformDates(t *testing.T) {
+	reform := &cal.ReformCalendar{
+		Adoption: time.Date(2023, time.March, 20, 0, 0, 0, 0, time.UTC),
+		Rule:     cal.AdoptAtNextMonth,
+	}
+
+	for i, input := range []struct {
+		time   time.Time
+		result string
+	}{
+		{time.Date(2023, time.March, 20, 0, 0, 0, 0, time.UTC), "March 20, 2023 (Gregorian)"},
+		{time.Date(2023, time.March, 25, 0, 0, 0, 0, time.UTC), "March 25, 2023 (Gregorian)"},
+		{time.Date(2023, time.March, 26, 12, 0, 0, 0, time.UTC), "April 1, 2023 (IFC)"},
+		{time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC), "December 29, 2023 (IFC)"},
+	} {
+		date := reform.DateAt(input.time)
+		if date.String() != input.result {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, input.result, date)
+		}
+	}
+
+	if _, err := reform.TimeOfGregorian(2023, time.April, 1); err != cal.ErrDateNotInCalendar {
+		t.Errorf("Expected Gregorian date after the reform to be rejected")
+	}
+	if _, err := reform.TimeOfIFC(cal.NewIFCDate(2023, cal.March, 28)); err != cal.ErrDateNotInCalendar {
+		t.Errorf("Expected IFC date before the reform to be rejected")
+	}
+	if day, err := reform.TimeOfIFC(cal.NewIFCDate(2023, cal.April, 1)); err != nil || !day.Equal(reform.EffectiveDate()) {
+		t.Errorf("Expected %+v but found %+v (%v)", reform.EffectiveDate(), day, err)
+	}
+}
@@ -0,0 +1,218 @@
+package cal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A Schedule is a cron-style expression evaluated in the IFC. It has five
+// fields separated by spaces:
+//
+//	minute (0-59) hour (0-23) day (1-29) month (1-13) weekday (0-6)
+//
+// Each field is *, a value, a range a-b or a comma-separated list of them,
+// optionally followed by a step /n. Months and weekdays may be given by
+// name or by their first three letters. The day and weekday fields also
+// accept the tokens LD and YD for Leap Day and Year Day; day 29 matches
+// both. As in cron, a day matches either field when both are restricted.
+type Schedule struct {
+	expr          string
+	minutes       uint64
+	hours         uint64
+	days          uint64
+	months        uint64
+	weekdays      uint64
+	anyDay        bool
+	anyWeekday    bool
+	dayTokens     uint64
+	weekdayTokens uint64
+}
+
+type scheduleField struct {
+	name     string
+	min, max int
+	names    func(string) (int, bool)
+}
+
+func monthByName(name string) (int, bool) {
+	for m := January; m <= December; m++ {
+		if name == strings.ToLower(english.MonthName(m)) || name == strings.ToLower(english.ShortMonthName(m)) {
+			return int(m), true
+		}
+	}
+	return 0, false
+}
+
+func weekdayByName(name string) (int, bool) {
+	for wd := Sunday; wd <= Saturday; wd++ {
+		lower := strings.ToLower(wd.String())
+		if name == lower || name == lower[:3] {
+			return int(wd), true
+		}
+	}
+	return 0, false
+}
+
+var (
+	minuteField  = scheduleField{"minute", 0, 59, nil}
+	hourField    = scheduleField{"hour", 0, 23, nil}
+	dayField     = scheduleField{"day", 1, daysInMonth + 1, nil}
+	monthField   = scheduleField{"month", 1, MonthsInYear, monthByName}
+	weekdayField = scheduleField{"weekday", int(Sunday), int(Saturday), weekdayByName}
+)
+
+// intercalaryToken returns the Weekday of an LD or YD token.
+func intercalaryToken(token string) (Weekday, bool) {
+	switch strings.ToLower(token) {
+	case "ld":
+		return LeapDay, true
+	case "yd":
+		return YearDay, true
+	}
+	return 0, false
+}
+
+func (f scheduleField) value(text string) (int, error) {
+	if f.names != nil {
+		if value, ok := f.names(strings.ToLower(text)); ok {
+			return value, nil
+		}
+	}
+	value, err := strconv.Atoi(text)
+	if err != nil || value < f.min || value > f.max {
+		return 0, fmt.Errorf("invalid %s value %s (use %d-%d)", f.name, text, f.min, f.max)
+	}
+	return value, nil
+}
+
+// parse returns the values of a field as a bit set and the LD and YD
+// tokens as a bit set of Weekdays.
+func (f scheduleField) parse(text string, allowTokens bool) (values uint64, tokens uint64, err error) {
+	for _, part := range strings.Split(text, ",") {
+		if wd, ok := intercalaryToken(part); ok && allowTokens {
+			tokens |= 1 << uint(wd)
+			continue
+		}
+
+		rangeText, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			rangeText = part[:i]
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step < 1 {
+				return 0, 0, fmt.Errorf("invalid step in %s field: %s", f.name, part)
+			}
+		}
+
+		first, last := f.min, f.max
+		if rangeText != "*" {
+			bounds := strings.SplitN(rangeText, "-", 2)
+			if first, err = f.value(bounds[0]); err != nil {
+				return 0, 0, err
+			}
+			last = first
+			if len(bounds) == 2 {
+				if last, err = f.value(bounds[1]); err != nil {
+					return 0, 0, err
+				}
+			} else if step > 1 {
+				last = f.max
+			}
+			if last < first {
+				return 0, 0, fmt.Errorf("invalid range in %s field: %s", f.name, part)
+			}
+		}
+
+		for value := first; value <= last; value += step {
+			values |= 1 << uint(value)
+		}
+	}
+	return values, tokens, nil
+}
+
+func ParseSchedule(expr string) (*Schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("schedule %q must have 5 fields: minute hour day month weekday", expr)
+	}
+
+	s := &Schedule{
+		expr:       expr,
+		anyDay:     fields[2] == "*",
+		anyWeekday: fields[4] == "*",
+	}
+	var err error
+	if s.minutes, _, err = minuteField.parse(fields[0], false); err != nil {
+		return nil, err
+	}
+	if s.hours, _, err = hourField.parse(fields[1], false); err != nil {
+		return nil, err
+	}
+	if s.days, s.dayTokens, err = dayField.parse(fields[2], true); err != nil {
+		return nil, err
+	}
+	if s.months, _, err = monthField.parse(fields[3], false); err != nil {
+		return nil, err
+	}
+	if s.weekdays, s.weekdayTokens, err = weekdayField.parse(fields[4], true); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Schedule) String() string {
+	return s.expr
+}
+
+func (s *Schedule) matchesDay(date *IFCDate) bool {
+	if s.months&(1<<uint(date.Month)) == 0 {
+		return false
+	}
+
+	weekday := date.Weekday()
+	dayMatches := s.days&(1<<uint(date.Day)) != 0 || s.dayTokens&(1<<uint(weekday)) != 0
+	weekdayMatches := s.weekdayTokens&(1<<uint(weekday)) != 0
+	if weekday <= Saturday {
+		weekdayMatches = s.weekdays&(1<<uint(weekday)) != 0
+	}
+
+	switch {
+	case s.anyDay && s.anyWeekday:
+		return true
+	case s.anyDay:
+		return weekdayMatches
+	case s.anyWeekday:
+		return dayMatches
+	}
+	return dayMatches || weekdayMatches
+}
+
+// Next returns the first time after the given one at which the schedule
+// fires, in the location of after. It returns the zero time if the
+// schedule never fires, such as on Leap Day in January.
+func (s *Schedule) Next(after time.Time) time.Time {
+	loc := after.Location()
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	hour, minute := t.Hour(), t.Minute()
+
+	for days := 0; days <= maxYearsWithoutOccurrence*(DaysInYear+1); days++ {
+		if s.matchesDay(DateAt(t)) {
+			for ; hour < 24; hour, minute = hour+1, 0 {
+				if s.hours&(1<<uint(hour)) == 0 {
+					continue
+				}
+				for ; minute < 60; minute++ {
+					if s.minutes&(1<<uint(minute)) != 0 {
+						y, m, d := t.Date()
+						return time.Date(y, m, d, hour, minute, 0, 0, loc)
+					}
+				}
+			}
+		}
+
+		y, m, d := t.Date()
+		t = time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+		hour, minute = 0, 0
+	}
+	return time.Time{}
+}
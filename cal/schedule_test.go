@@ -0,0 +1,71 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestScheduleNext(t *testing.T) {
+	for i, input := range []struct {
+		expr   string
+		after  time.Time
+		result time.Time
+	}{
+		{"0 0 1 * *", time.Date(2023, time.January, 28, 12, 0, 0, 0, time.UTC), time.Date(2023, time.January, 29, 0, 0, 0, 0, time.UTC)},
+		{"30 9 YD * *", time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC), time.Date(2022, time.December, 31, 9, 30, 0, 0, time.UTC)},
+		{"0 0 * * LD", time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, time.June, 17, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 jun *", time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, time.June, 17, 0, 0, 0, 0, time.UTC)},
+		{"0 12 * * fri", time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, time.January, 6, 12, 0, 0, 0, time.UTC)},
+		{"0 12 * * fri", time.Date(2023, time.January, 6, 12, 0, 0, 0, time.UTC), time.Date(2023, time.January, 13, 12, 0, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2023, time.January, 6, 10, 7, 30, 0, time.UTC), time.Date(2023, time.January, 6, 10, 15, 0, 0, time.UTC)},
+		{"0 8 1 sol *", time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, time.June, 18, 8, 0, 0, 0, time.UTC)},
+		{"0 0 LD jan *", time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), time.Time{}},
+	} {
+		schedule, err := cal.ParseSchedule(input.expr)
+		if err != nil {
+			t.Errorf("%d: Unexpected error %s\n", i, err)
+			continue
+		}
+		if next := schedule.Next(input.after); !next.Equal(input.result) {
+			t.Errorf("%d: Expected %s to fire at %s but found %s\n", i, input.expr, input.result, next)
+		}
+	}
+}
+
+func TestScheduleInLocation(t *testing.T) {
+	tzHelsinki, err := time.LoadLocation("Europe/Helsinki")
+	if err != nil {
+		t.Fatalf("Error loading Helsinki timezone")
+	}
+
+	// Year Day has begun in Helsinki but not yet in UTC.
+	schedule, _ := cal.ParseSchedule("0 0 YD * *")
+	after := time.Date(2022, time.December, 30, 23, 30, 0, 0, time.UTC)
+	for _, expected := range []time.Time{
+		time.Date(2022, time.December, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, time.December, 31, 0, 0, 0, 0, tzHelsinki),
+	} {
+		if next := schedule.Next(after.In(expected.Location())); !next.Equal(expected) {
+			t.Errorf("Expected %s but found %s\n", expected, next)
+		}
+	}
+}
+
+func TestInvalidSchedules(t *testing.T) {
+	for i, expr := range []string{
+		"0 0 30 * *",
+		"61 * * * *",
+		"0 0 * *",
+		"0 0 * 14 *",
+		"0 0 * * 7",
+		"0 0 LD * * *",
+		"0 LD * * *",
+		"0 0 5-2 * *",
+	} {
+		if _, err := cal.ParseSchedule(expr); err == nil {
+			t.Errorf("%d: Expected an error for %s\n", i, expr)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package cal
+
+import (
+	"fmt"
+	"time"
+)
+
+// A SeasonalEvent is an equinox or a solstice.
+type SeasonalEvent int
+
+const (
+	MarchEquinox SeasonalEvent = iota
+	JuneSolstice
+	SeptemberEquinox
+	DecemberSolstice
+)
+
+var seasonalEventNames = []string{
+	"March equinox",
+	"June solstice",
+	"September equinox",
+	"December solstice",
+}
+
+func (e SeasonalEvent) String() string {
+	if e >= MarchEquinox && e <= DecemberSolstice {
+		return seasonalEventNames[e]
+	}
+	return fmt.Sprintf("%%!SeasonalEvent(%d)", int(e))
+}
+
+// Mean instants of the events as Julian Ephemeris Days (Meeus, chapter
+// 27) for the years -1000 to 1000 and 1000 to 3000. They are good enough
+// for a few thousand years around them.
+var (
+	meanSeasonalEventsBefore1000 = [][]float64{
+		{1721139.29189, 365242.13740, 0.06134, 0.00111, -0.00071},
+		{1721233.25401, 365241.72562, -0.05323, 0.00907, 0.00025},
+		{1721325.70455, 365242.49558, -0.11677, -0.00297, 0.00074},
+		{1721414.39987, 365242.88257, -0.00769, -0.00933, -0.00006},
+	}
+	meanSeasonalEventsAfter1000 = [][]float64{
+		{2451623.80984, 365242.37404, 0.05169, -0.00411, -0.00057},
+		{2451716.56767, 365241.62603, 0.00325, 0.00888, -0.00030},
+		{2451810.21715, 365242.01767, -0.11575, 0.00337, 0.00078},
+		{2451900.05952, 365242.74049, -0.06223, -0.00823, 0.00032},
+	}
+)
+
+// Periodic terms A, B and C correcting the mean instants.
+var seasonalEventTerms = [][3]float64{
+	{485, 324.96, 1934.136}, {203, 337.23, 32964.467}, {199, 342.08, 20.186},
+	{182, 27.85, 445267.112}, {156, 73.14, 45036.886}, {136, 171.52, 22518.443},
+	{77, 222.54, 65928.934}, {74, 296.72, 3034.906}, {70, 243.58, 9037.513},
+	{58, 119.81, 33718.147}, {52, 297.17, 150.678}, {50, 21.02, 2281.226},
+	{45, 247.54, 29929.562}, {44, 325.15, 31555.956}, {29, 60.93, 4443.417},
+	{18, 155.12, 67555.328}, {17, 288.79, 4562.452}, {16, 198.04, 62894.029},
+	{14, 199.76, 31436.921}, {12, 95.39, 14577.848}, {12, 287.11, 31931.756},
+	{12, 320.81, 34777.259}, {9, 227.73, 1222.114}, {8, 15.45, 16859.074},
+}
+
+// Time returns the instant of the event in a Gregorian year in UTC.
+func (e SeasonalEvent) Time(year int) time.Time {
+	var jde0 float64
+	if year < 1000 {
+		jde0 = polynomial(float64(year)/1000, meanSeasonalEventsBefore1000[e]...)
+	} else {
+		jde0 = polynomial(float64(year-2000)/1000, meanSeasonalEventsAfter1000[e]...)
+	}
+
+	t := (jde0 - 2451545.0) / 36525
+	w := 35999.373*t - 2.47
+	deltaLambda := 1 + 0.0334*cosDegrees(w) + 0.0007*cosDegrees(2*w)
+	s := 0.0
+	for _, term := range seasonalEventTerms {
+		s += term[0] * cosDegrees(term[1]+term[2]*t)
+	}
+
+	return timeFromEphemerisDay(jde0 + 0.00001*s/deltaLambda).Round(time.Second)
+}
+
+// Date returns the IFC date of the event in a Gregorian year in the given
+// location.
+func (e SeasonalEvent) Date(year int, loc *time.Location) *IFCDate {
+	return DateAt(e.Time(year).In(loc))
+}
+
+// SeasonalEvents returns the equinoxes and solstices of a year in order.
+func SeasonalEvents(year int) []time.Time {
+	times := make([]time.Time, DecemberSolstice+1)
+	for e := MarchEquinox; e <= DecemberSolstice; e++ {
+		times[e] = e.Time(year)
+	}
+	return times
+}
+
+// A Season is an astronomical season of the northern hemisphere, which
+// starts at an equinox or a solstice.
+type Season int
+
+const (
+	Spring Season = iota
+	Summer
+	Autumn
+	Winter
+)
+
+var seasonNames = []string{
+	"Spring",
+	"Summer",
+	"Autumn",
+	"Winter",
+}
+
+func (s Season) String() string {
+	if s >= Spring && s <= Winter {
+		return seasonNames[s]
+	}
+	return fmt.Sprintf("%%!Season(%d)", int(s))
+}
+
+// Opposite returns the season of the other hemisphere.
+func (s Season) Opposite() Season {
+	return Season(mod(int(s)+2, 4))
+}
+
+// SeasonOf returns the northern hemisphere season of an IFC date in the
+// given location. A season changes on the day of its equinox or solstice.
+func SeasonOf(date *IFCDate, loc *time.Location) Season {
+	season := Winter
+	for e := MarchEquinox; e <= DecemberSolstice; e++ {
+		if !e.Date(date.Year, loc).After(date) {
+			season = Season(e)
+		}
+	}
+	return season
+}
+
+// seasonalEvents provides the equinoxes and solstices as observances in a
+// time zone.
+type seasonalEvents struct {
+	loc *time.Location
+}
+
+func (s seasonalEvents) Holidays(year int) []Holiday {
+	// The events stay in their Gregorian years in every time zone.
+	holidays := make([]Holiday, 0, DecemberSolstice+1)
+	for e := MarchEquinox; e <= DecemberSolstice; e++ {
+		holidays = append(holidays, Holiday{Name: e.String(), Date: e.Date(year, s.loc)})
+	}
+	return holidays
+}
+
+// SeasonalEventsIn returns a HolidayProvider for the days of the
+// equinoxes and solstices in a time zone.
+func SeasonalEventsIn(loc *time.Location) HolidayProvider {
+	return seasonalEvents{loc: loc}
+}
@@ -0,0 +1,68 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestSeasonalEventTimes(t *testing.T) {
+	for i, input := range []struct {
+		event cal.SeasonalEvent
+		year  int
+		time  time.Time
+	}{
+		{cal.MarchEquinox, 2000, time.Date(2000, time.March, 20, 7, 35, 0, 0, time.UTC)},
+		{cal.JuneSolstice, 2000, time.Date(2000, time.June, 21, 1, 48, 0, 0, time.UTC)},
+		{cal.SeptemberEquinox, 2000, time.Date(2000, time.September, 22, 17, 28, 0, 0, time.UTC)},
+		{cal.DecemberSolstice, 2000, time.Date(2000, time.December, 21, 13, 37, 0, 0, time.UTC)},
+		{cal.MarchEquinox, 2024, time.Date(2024, time.March, 20, 3, 6, 0, 0, time.UTC)},
+		{cal.JuneSolstice, 2024, time.Date(2024, time.June, 20, 20, 51, 0, 0, time.UTC)},
+		{cal.SeptemberEquinox, 2024, time.Date(2024, time.September, 22, 12, 44, 0, 0, time.UTC)},
+		{cal.DecemberSolstice, 2024, time.Date(2024, time.December, 21, 9, 20, 0, 0, time.UTC)},
+	} {
+		eventTime := input.event.Time(input.year)
+		if diff := eventTime.Sub(input.time); diff < -2*time.Minute || diff > 2*time.Minute {
+			t.Errorf("%d: Expected %s at %s but found %s\n", i, input.event, input.time, eventTime)
+		}
+	}
+}
+
+func TestSeasonalEventDates(t *testing.T) {
+	tzTokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("Error loading Tokyo timezone")
+	}
+
+	// The June solstice of 2024 is on 20 June in UTC but on 21 June in
+	// Tokyo.
+	if date := cal.JuneSolstice.Date(2024, time.UTC); !date.Equal(cal.NewIFCDate(2024, cal.Sol, 3)) {
+		t.Errorf("Expected the solstice on 3 Sol but found %+v\n", date)
+	}
+	if date := cal.JuneSolstice.Date(2024, tzTokyo); !date.Equal(cal.NewIFCDate(2024, cal.Sol, 4)) {
+		t.Errorf("Expected the solstice on 4 Sol but found %+v\n", date)
+	}
+}
+
+func TestSeasonOf(t *testing.T) {
+	for i, input := range []struct {
+		date   *cal.IFCDate
+		season cal.Season
+	}{
+		{cal.NewIFCDate(2024, cal.January, 1), cal.Winter},
+		{cal.NewIFCDate(2024, cal.March, 23), cal.Winter},
+		{cal.NewIFCDate(2024, cal.March, 24), cal.Spring},
+		{cal.NewIFCDate(2024, cal.Sol, 3), cal.Summer},
+		{cal.NewIFCDate(2024, cal.October, 13), cal.Autumn},
+		{cal.NewIFCDate(2024, cal.December, 29), cal.Winter},
+	} {
+		if season := cal.SeasonOf(input.date, time.UTC); season != input.season {
+			t.Errorf("%d: Expected %s but found %s\n", i, input.season, season)
+		}
+	}
+
+	if cal.Summer.Opposite() != cal.Winter || cal.Autumn.Opposite() != cal.Spring {
+		t.Errorf("Expected opposite seasons in the southern hemisphere\n")
+	}
+}
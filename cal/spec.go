@@ -0,0 +1,384 @@
+package cal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// A CalendarSpec declares a perennial calendar: a year of months whose days
+// fall on the same weekdays every year, plus intercalary days that belong
+// to no week. Intercalary days are placed at the end of the month they
+// follow, the way the IFC places Leap Day and Year Day. Specs are usually
+// loaded from JSON with LoadCalendar.
+type CalendarSpec struct {
+	Name        string            `json:"name"`
+	Months      []MonthSpec       `json:"months"`
+	Weekdays    []WeekdaySpec     `json:"weekdays"`
+	Intercalary []IntercalarySpec `json:"intercalary"`
+
+	// LeapRule is "gregorian", "julian" or "none". Gregorian calendars
+	// start every year on the epoch's Gregorian month and day. Julian
+	// calendars have a leap year whenever the year is divisible by four.
+	LeapRule string    `json:"leapRule"`
+	Epoch    EpochSpec `json:"epoch"`
+}
+
+type MonthSpec struct {
+	Name  string `json:"name"`
+	Short string `json:"short"`
+	Days  int    `json:"days"`
+}
+
+type WeekdaySpec struct {
+	Name  string `json:"name"`
+	Short string `json:"short"`
+}
+
+type IntercalarySpec struct {
+	Name  string `json:"name"`
+	Short string `json:"short"`
+	After int    `json:"after"` // Number of the month the day follows.
+	Leap  bool   `json:"leap"`  // Only in leap years.
+}
+
+// EpochSpec aligns the first day of the calendar year Year with a
+// proleptic Gregorian date.
+type EpochSpec struct {
+	Year      int `json:"year"`
+	Gregorian struct {
+		Year  int `json:"year"`
+		Month int `json:"month"`
+		Day   int `json:"day"`
+	} `json:"gregorian"`
+}
+
+const (
+	GregorianLeapRule = "gregorian"
+	JulianLeapRule    = "julian"
+	NoLeapRule        = "none"
+)
+
+// IFCSpec declares the International Fixed Calendar with the same
+// constants and names as IFCDate. It only mirrors the IFC: IFCDate does not
+// read it, so changing it does not change IFC dates.
+var IFCSpec = func() CalendarSpec {
+	spec := CalendarSpec{
+		Name:     "International Fixed Calendar",
+		LeapRule: GregorianLeapRule,
+		Intercalary: []IntercalarySpec{
+			{Name: LeapDay.String(), Short: LeapDay.ShortFormat(), After: int(June), Leap: true},
+			{Name: YearDay.String(), Short: YearDay.ShortFormat(), After: int(December)},
+		},
+	}
+	for m := January; m <= December; m++ {
+		spec.Months = append(spec.Months, MonthSpec{Name: m.String(), Short: english.ShortMonthName(m), Days: daysInMonth})
+	}
+	for wd := Sunday; wd <= Saturday; wd++ {
+		spec.Weekdays = append(spec.Weekdays, WeekdaySpec{Name: wd.String(), Short: wd.ShortFormat()})
+	}
+	spec.Epoch.Year = 1
+	spec.Epoch.Gregorian.Year = 1
+	spec.Epoch.Gregorian.Month = 1
+	spec.Epoch.Gregorian.Day = 1
+	return spec
+}()
+
+// WorldCalendarSpec declares the World Calendar of Elisabeth Achelis, in
+// which every quarter has months of 31, 30 and 30 days and starts on a
+// Sunday. Worldsday follows December and Leapyear Day follows June.
+var WorldCalendarSpec = func() CalendarSpec {
+	spec := IFCSpec
+	spec.Name = "World Calendar"
+	spec.Weekdays = append([]WeekdaySpec(nil), IFCSpec.Weekdays...)
+	spec.Months = nil
+	for month := time.January; month <= time.December; month++ {
+		days := 30
+		if month%3 == 1 {
+			days = 31
+		}
+		spec.Months = append(spec.Months, MonthSpec{Name: month.String(), Short: month.String()[:3], Days: days})
+	}
+	spec.Intercalary = []IntercalarySpec{
+		{Name: "Leapyear Day", Short: "LD", After: 6, Leap: true},
+		{Name: "Worldsday", Short: "W", After: 12},
+	}
+	return spec
+}()
+
+type PerennialCalendar struct {
+	spec       CalendarSpec
+	epochFixed int
+	daysInYear int // Days in a common year.
+}
+
+func LoadCalendar(r io.Reader) (*PerennialCalendar, error) {
+	var spec CalendarSpec
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&spec); err != nil {
+		return nil, fmt.Errorf("invalid calendar spec: %w", err)
+	}
+	return NewPerennialCalendar(spec)
+}
+
+func NewPerennialCalendar(spec CalendarSpec) (*PerennialCalendar, error) {
+	if len(spec.Months) == 0 {
+		return nil, errors.New("calendar spec has no months")
+	}
+	if len(spec.Weekdays) == 0 {
+		return nil, errors.New("calendar spec has no weekdays")
+	}
+
+	daysInYear := 0
+	for _, month := range spec.Months {
+		if month.Days < 1 {
+			return nil, fmt.Errorf("month %s has no days", month.Name)
+		}
+		daysInYear += month.Days
+	}
+
+	leapDays := 0
+	for _, day := range spec.Intercalary {
+		if day.After < 1 || day.After > len(spec.Months) {
+			return nil, fmt.Errorf("intercalary day %s follows unknown month %d", day.Name, day.After)
+		}
+		if day.Leap {
+			leapDays++
+		} else {
+			daysInYear++
+		}
+	}
+
+	switch spec.LeapRule {
+	case GregorianLeapRule, JulianLeapRule:
+		if daysInYear != DaysInYear || leapDays != 1 {
+			return nil, fmt.Errorf("%s leap rule requires %d days and one leap day per year, found %d days and %d leap days",
+				spec.LeapRule, DaysInYear, daysInYear, leapDays)
+		}
+	case NoLeapRule:
+		if leapDays != 0 {
+			return nil, errors.New("leap days require a leap rule")
+		}
+	default:
+		return nil, fmt.Errorf("unknown leap rule: %s", spec.LeapRule)
+	}
+
+	epoch := spec.Epoch.Gregorian
+	if epoch.Month < 1 || epoch.Month > 12 || epoch.Day < 1 || epoch.Day > daysInGregorianMonth(epoch.Year, time.Month(epoch.Month)) {
+		return nil, fmt.Errorf("invalid epoch %d-%d-%d", epoch.Year, epoch.Month, epoch.Day)
+	}
+
+	return &PerennialCalendar{
+		spec:       spec,
+		epochFixed: FixedFromTime(time.Date(epoch.Year, time.Month(epoch.Month), epoch.Day, 0, 0, 0, 0, time.UTC)),
+		daysInYear: daysInYear,
+	}, nil
+}
+
+func daysInGregorianMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func (c *PerennialCalendar) Name() string {
+	return c.spec.Name
+}
+
+func (c *PerennialCalendar) Spec() CalendarSpec {
+	return c.spec
+}
+
+func (c *PerennialCalendar) MonthsInYear() int {
+	return len(c.spec.Months)
+}
+
+func (c *PerennialCalendar) DaysInWeek() int {
+	return len(c.spec.Weekdays)
+}
+
+func (c *PerennialCalendar) MonthName(month int) string {
+	if month > 0 && month <= len(c.spec.Months) {
+		return c.spec.Months[month-1].Name
+	}
+	return fmt.Sprintf("%%!Month(%d)", month)
+}
+
+func (c *PerennialCalendar) WeekdayName(weekday int) string {
+	if weekday >= 0 && weekday < len(c.spec.Weekdays) {
+		return c.spec.Weekdays[weekday].Name
+	}
+	return fmt.Sprintf("%%!Weekday(%d)", weekday)
+}
+
+func (c *PerennialCalendar) ShortWeekdayName(weekday int) string {
+	if weekday >= 0 && weekday < len(c.spec.Weekdays) {
+		return c.spec.Weekdays[weekday].Short
+	}
+	return fmt.Sprintf("%%!Weekday(%d)", weekday)
+}
+
+func (c *PerennialCalendar) IsLeapYear(year int) bool {
+	switch c.spec.LeapRule {
+	case GregorianLeapRule:
+		return c.yearStartFixed(year+1)-c.yearStartFixed(year) > c.daysInYear
+	case JulianLeapRule:
+		return mod(year, 4) == 0
+	}
+	return false
+}
+
+// DaysInMonth returns the regular days of a month, or 0 for an unknown
+// month. Intercalary days following the month are counted by
+// IntercalaryDays.
+func (c *PerennialCalendar) DaysInMonth(month int) int {
+	if month > 0 && month <= len(c.spec.Months) {
+		return c.spec.Months[month-1].Days
+	}
+	return 0
+}
+
+// IntercalaryDays returns the intercalary days following a month in the
+// given year.
+func (c *PerennialCalendar) IntercalaryDays(year int, month int) []IntercalarySpec {
+	var days []IntercalarySpec
+	for _, day := range c.spec.Intercalary {
+		if day.After == month && (!day.Leap || c.IsLeapYear(year)) {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
+// MaxIntercalaryDays returns the largest number of intercalary days that
+// follow any single month.
+func (c *PerennialCalendar) MaxIntercalaryDays() int {
+	max := 0
+	for month := 1; month <= c.MonthsInYear(); month++ {
+		count := 0
+		for _, day := range c.spec.Intercalary {
+			if day.After == month {
+				count++
+			}
+		}
+		if count > max {
+			max = count
+		}
+	}
+	return max
+}
+
+func (c *PerennialCalendar) yearStartFixed(year int) int {
+	yearsSinceEpoch := year - c.spec.Epoch.Year
+	switch c.spec.LeapRule {
+	case GregorianLeapRule:
+		epoch := c.spec.Epoch.Gregorian
+		start := time.Date(epoch.Year+yearsSinceEpoch, time.Month(epoch.Month), epoch.Day, 0, 0, 0, 0, time.UTC)
+		return FixedFromTime(start)
+	case JulianLeapRule:
+		leapYears := floorDiv(year-1, 4) - floorDiv(c.spec.Epoch.Year-1, 4)
+		return c.epochFixed + c.daysInYear*yearsSinceEpoch + leapYears
+	}
+	return c.epochFixed + c.daysInYear*yearsSinceEpoch
+}
+
+func (c *PerennialCalendar) NewDate(year int, month int, day int) *PerennialDate {
+	return &PerennialDate{Year: year, Month: month, Day: day, calendar: c}
+}
+
+func (c *PerennialCalendar) DateAt(t time.Time) *PerennialDate {
+	return c.DateFromFixed(FixedFromTime(t))
+}
+
+func (c *PerennialCalendar) DateFromFixed(fixed int) *PerennialDate {
+	year := c.spec.Epoch.Year + floorDiv(fixed-c.epochFixed, c.daysInYear)
+	for fixed < c.yearStartFixed(year) {
+		year--
+	}
+	for fixed >= c.yearStartFixed(year+1) {
+		year++
+	}
+
+	remaining := fixed - c.yearStartFixed(year)
+	for month := 1; ; month++ {
+		daysInMonth := c.DaysInMonth(month) + len(c.IntercalaryDays(year, month))
+		if remaining < daysInMonth {
+			return c.NewDate(year, month, remaining+1)
+		}
+		remaining -= daysInMonth
+	}
+}
+
+func (c *PerennialCalendar) dayOfYear(d *PerennialDate) int {
+	dayOfYear := d.Day
+	for month := 1; month < d.Month; month++ {
+		dayOfYear += c.DaysInMonth(month) + len(c.IntercalaryDays(d.Year, month))
+	}
+	return dayOfYear
+}
+
+// A PerennialDate is a date in a PerennialCalendar. Intercalary days have
+// day numbers after the regular days of the month they follow.
+type PerennialDate struct {
+	Year     int
+	Month    int
+	Day      int
+	calendar *PerennialCalendar
+}
+
+func (d *PerennialDate) Calendar() *PerennialCalendar {
+	return d.calendar
+}
+
+func (d *PerennialDate) Fixed() int {
+	return d.calendar.yearStartFixed(d.Year) + d.calendar.dayOfYear(d) - 1
+}
+
+func (d *PerennialDate) ToUTCTime() time.Time {
+	return TimeFromFixed(d.Fixed())
+}
+
+func (d *PerennialDate) ToIFCDate() *IFCDate {
+	return DateFromFixed(d.Fixed())
+}
+
+func (d *PerennialDate) Equal(other *PerennialDate) bool {
+	return d.Day == other.Day && d.Month == other.Month && d.Year == other.Year && d.calendar == other.calendar
+}
+
+func (d *PerennialDate) IsIntercalary() bool {
+	return d.Day > d.calendar.DaysInMonth(d.Month)
+}
+
+// Intercalary returns the spec of an intercalary day, or nil for days
+// that are part of a week.
+func (d *PerennialDate) Intercalary() *IntercalarySpec {
+	extra := d.Day - d.calendar.DaysInMonth(d.Month)
+	days := d.calendar.IntercalaryDays(d.Year, d.Month)
+	if extra < 1 || extra > len(days) {
+		return nil
+	}
+	return &days[extra-1]
+}
+
+// Weekday returns the zero-based index of the weekday, or -1 for
+// intercalary days. Weeks restart at the beginning of every year.
+func (d *PerennialDate) Weekday() int {
+	if d.IsIntercalary() {
+		return -1
+	}
+
+	daysBefore := d.Day - 1
+	for month := 1; month < d.Month; month++ {
+		daysBefore += d.calendar.DaysInMonth(month)
+	}
+	return daysBefore % d.calendar.DaysInWeek()
+}
+
+func (d *PerennialDate) String() string {
+	if day := d.Intercalary(); day != nil {
+		return fmt.Sprintf("%s %d", day.Name, d.Year)
+	}
+	return fmt.Sprintf("%s, %d %s %d", d.calendar.WeekdayName(d.Weekday()), d.Day, d.calendar.MonthName(d.Month), d.Year)
+}
@@ -0,0 +1,152 @@
+package cal_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestIFCSpecAgreesWithIFCDate(t *testing.T) {
+	ifc, err := cal.NewPerennialCalendar(cal.IFCSpec)
+	if err != nil {
+		t.Fatalf("Error creating IFC calendar: %s", err)
+	}
+
+	start := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for day := start; day.Year() < 2026; day = day.AddDate(0, 0, 1) {
+		ifcDate := cal.DateAt(day)
+		specDate := ifc.DateAt(day)
+		if specDate.Year != ifcDate.Year || specDate.Month != int(ifcDate.Month) || specDate.Day != ifcDate.Day {
+			t.Fatalf("%s: Expected %+v but found %+v\n", day.Format("2006-01-02"), ifcDate, specDate)
+		}
+
+		weekday := specDate.Weekday()
+		if specDate.IsIntercalary() {
+			if ifcDate.Weekday() != cal.LeapDay && ifcDate.Weekday() != cal.YearDay {
+				t.Fatalf("%s: Expected %s but found an intercalary day\n", day.Format("2006-01-02"), ifcDate.Weekday())
+			}
+		} else if weekday != int(ifcDate.Weekday()) {
+			t.Fatalf("%s: Expected %s but found %s\n", day.Format("2006-01-02"), ifcDate.Weekday(), ifc.WeekdayName(weekday))
+		}
+
+		if !specDate.ToUTCTime().Equal(day) {
+			t.Fatalf("%s: Round trip produced %+v\n", day.Format("2006-01-02"), specDate.ToUTCTime())
+		}
+	}
+}
+
+func TestWorldCalendar(t *testing.T) {
+	world, err := cal.NewPerennialCalendar(cal.WorldCalendarSpec)
+	if err != nil {
+		t.Fatalf("Error creating World Calendar: %s", err)
+	}
+
+	for i, input := range []struct {
+		time   time.Time
+		result string
+	}{
+		{time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), "Sunday, 1 January 2023"},
+		{time.Date(2023, time.April, 2, 0, 0, 0, 0, time.UTC), "Sunday, 1 April 2023"},
+		{time.Date(2023, time.December, 30, 0, 0, 0, 0, time.UTC), "Saturday, 30 December 2023"},
+		{time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC), "Worldsday 2023"},
+		{time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC), "Leapyear Day 2024"},
+		{time.Date(2024, time.July, 2, 0, 0, 0, 0, time.UTC), "Sunday, 1 July 2024"},
+	} {
+		date := world.DateAt(input.time)
+		if date.String() != input.result {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, input.result, date)
+		}
+	}
+}
+
+const testSpec = `{
+	"name": "Decimal",
+	"months": [
+		{"name": "Primidi", "short": "Pri", "days": 61},
+		{"name": "Duodi", "short": "Duo", "days": 61},
+		{"name": "Tridi", "short": "Tri", "days": 61},
+		{"name": "Quartidi", "short": "Qua", "days": 61},
+		{"name": "Quintidi", "short": "Qui", "days": 61},
+		{"name": "Sextidi", "short": "Sex", "days": 59}
+	],
+	"weekdays": [
+		{"name": "One", "short": "1"}, {"name": "Two", "short": "2"},
+		{"name": "Three", "short": "3"}, {"name": "Four", "short": "4"},
+		{"name": "Five", "short": "5"}, {"name": "Six", "short": "6"},
+		{"name": "Seven", "short": "7"}, {"name": "Eight", "short": "8"},
+		{"name": "Nine", "short": "9"}, {"name": "Ten", "short": "10"}
+	],
+	"intercalary": [
+		{"name": "Festival", "short": "F", "after": 6},
+		{"name": "Leap Festival", "short": "LF", "after": 6, "leap": true}
+	],
+	"leapRule": "julian",
+	"epoch": {"year": 1, "gregorian": {"year": 2000, "month": 3, "day": 20}}
+}`
+
+func TestWorldCalendarSpecOwnsItsWeekdays(t *testing.T) {
+	if &cal.WorldCalendarSpec.Weekdays[0] == &cal.IFCSpec.Weekdays[0] {
+		t.Error("WorldCalendarSpec shares its weekdays with IFCSpec")
+	}
+}
+
+func TestLoadCalendar(t *testing.T) {
+	calendar, err := cal.LoadCalendar(strings.NewReader(testSpec))
+	if err != nil {
+		t.Fatalf("Error loading calendar: %s", err)
+	}
+
+	for i, input := range []struct {
+		time   time.Time
+		result string
+	}{
+		{time.Date(2000, time.March, 20, 0, 0, 0, 0, time.UTC), "One, 1 Primidi 1"},
+		{time.Date(2000, time.May, 20, 0, 0, 0, 0, time.UTC), "Two, 1 Duodi 1"},
+		{time.Date(2001, time.March, 19, 0, 0, 0, 0, time.UTC), "Festival 1"},
+		{time.Date(2001, time.March, 20, 0, 0, 0, 0, time.UTC), "One, 1 Primidi 2"},
+		{time.Date(2004, time.March, 18, 0, 0, 0, 0, time.UTC), "Festival 4"},
+		{time.Date(2004, time.March, 19, 0, 0, 0, 0, time.UTC), "Leap Festival 4"},
+		{time.Date(2004, time.March, 20, 0, 0, 0, 0, time.UTC), "One, 1 Primidi 5"},
+	} {
+		date := calendar.DateAt(input.time)
+		if date.String() != input.result {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, input.result, date)
+		}
+		if !date.ToUTCTime().Equal(input.time) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.time, date.ToUTCTime())
+		}
+	}
+}
+
+func TestInvalidCalendarSpecs(t *testing.T) {
+	for i, spec := range []string{
+		`{"name": "Empty"}`,
+		`{"months": [{"name": "A", "days": 365}], "weekdays": [{"name": "X"}], "leapRule": "lunar"}`,
+		`{"months": [{"name": "A", "days": 300}], "weekdays": [{"name": "X"}], "leapRule": "gregorian"}`,
+		`{"months": [{"name": "A", "days": 365}], "weekdays": [{"name": "X"}], "leapRule": "none", "unknown": 1}`,
+		`{"months": [{"name": "A", "days": 365}], "weekdays": [{"name": "X"}], "leapRule": "none", "epoch": {"gregorian": {"year": 2021, "month": 2, "day": 29}}}`,
+		`{"months": [{"name": "A", "days": 365}], "weekdays": [{"name": "X"}], "leapRule": "none", "epoch": {"gregorian": {"year": 2020, "month": 4, "day": 31}}}`,
+	} {
+		if _, err := cal.LoadCalendar(strings.NewReader(spec)); err == nil {
+			t.Errorf("%d: Expected an error for spec %s\n", i, spec)
+		}
+	}
+}
+
+func TestPerennialDaysInMonth(t *testing.T) {
+	calendar, err := cal.LoadCalendar(strings.NewReader(testSpec))
+	if err != nil {
+		t.Fatalf("Error loading calendar: %s", err)
+	}
+
+	for _, month := range []int{0, -1, calendar.MonthsInYear() + 1} {
+		if days := calendar.DaysInMonth(month); days != 0 {
+			t.Errorf("Expected 0 days in month %d but found %d\n", month, days)
+		}
+	}
+	if days := calendar.DaysInMonth(1); days == 0 {
+		t.Errorf("Expected days in month 1\n")
+	}
+}
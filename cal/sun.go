@@ -0,0 +1,92 @@
+package cal
+
+import (
+	"math"
+	"time"
+)
+
+// A Place is a point on Earth with the time zone in which its times are
+// shown. Latitudes are positive to the north and longitudes to the east.
+type Place struct {
+	Latitude  float64
+	Longitude float64
+	TimeZone  *time.Location
+}
+
+// SolarDay holds the times of the Sun's passage on a day at a place.
+// During midnight sun Sunrise and Sunset are zero and PolarDay is set;
+// during polar night they are zero and PolarNight is set. Civil twilight
+// times are zero when the Sun does not reach 6 degrees below the horizon.
+type SolarDay struct {
+	Date       *IFCDate
+	CivilDawn  time.Time
+	Sunrise    time.Time
+	SolarNoon  time.Time
+	Sunset     time.Time
+	CivilDusk  time.Time
+	DayLength  time.Duration
+	PolarDay   bool
+	PolarNight bool
+}
+
+const (
+	// Sunrise and sunset happen when the centre of the Sun is 50 minutes
+	// of arc below the horizon, allowing for refraction and its radius.
+	sunriseAltitude       = -0.833
+	civilTwilightAltitude = -6.0
+	obliquityOfEcliptic   = 23.4397
+)
+
+// hourAngle returns the hour angle in degrees at which the Sun reaches an
+// altitude, and whether it stays above (1) or below (-1) it all day.
+func hourAngle(altitude, latitude, declination float64) (float64, int) {
+	cosAngle := (sinDegrees(altitude) - sinDegrees(latitude)*sinDegrees(declination)) /
+		(cosDegrees(latitude) * cosDegrees(declination))
+	switch {
+	case cosAngle < -1:
+		return 0, 1
+	case cosAngle > 1:
+		return 0, -1
+	}
+	return math.Acos(cosAngle) * 180 / math.Pi, 0
+}
+
+// SolarDay computes the times with the sunrise equation, which is accurate
+// to a minute or two outside the polar regions.
+func (p *Place) SolarDay(date *IFCDate) *SolarDay {
+	loc := p.TimeZone
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	// Days from the J2000 epoch to the mean solar noon at the longitude.
+	noon := float64(date.Fixed()-unixEpochFixed) + julianDayUnixEpoch + 0.5
+	meanNoon := math.Round(noon-2451545.0-0.0008) + 0.0008 - p.Longitude/360
+
+	meanAnomaly := math.Mod(357.5291+0.98560028*meanNoon, 360)
+	center := 1.9148*sinDegrees(meanAnomaly) + 0.0200*sinDegrees(2*meanAnomaly) + 0.0003*sinDegrees(3*meanAnomaly)
+	eclipticLongitude := math.Mod(meanAnomaly+center+180+102.9372, 360)
+	transit := 2451545.0 + meanNoon + 0.0053*sinDegrees(meanAnomaly) - 0.0069*sinDegrees(2*eclipticLongitude)
+	declination := math.Asin(sinDegrees(eclipticLongitude)*sinDegrees(obliquityOfEcliptic)) * 180 / math.Pi
+
+	day := &SolarDay{Date: date, SolarNoon: timeFromJulianDay(transit).In(loc).Round(time.Second)}
+
+	angle, polar := hourAngle(sunriseAltitude, p.Latitude, declination)
+	switch polar {
+	case 1:
+		day.PolarDay = true
+		day.DayLength = 24 * time.Hour
+	case -1:
+		day.PolarNight = true
+	default:
+		day.Sunrise = timeFromJulianDay(transit - angle/360).In(loc).Round(time.Second)
+		day.Sunset = timeFromJulianDay(transit + angle/360).In(loc).Round(time.Second)
+		day.DayLength = day.Sunset.Sub(day.Sunrise)
+	}
+
+	if angle, polar := hourAngle(civilTwilightAltitude, p.Latitude, declination); polar == 0 {
+		day.CivilDawn = timeFromJulianDay(transit - angle/360).In(loc).Round(time.Second)
+		day.CivilDusk = timeFromJulianDay(transit + angle/360).In(loc).Round(time.Second)
+	}
+	return day
+}
@@ -0,0 +1,61 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestSolarDay(t *testing.T) {
+	tzHelsinki, err := time.LoadLocation("Europe/Helsinki")
+	if err != nil {
+		t.Fatalf("Error loading Helsinki timezone")
+	}
+	helsinki := &cal.Place{Latitude: 60.1699, Longitude: 24.9384, TimeZone: tzHelsinki}
+
+	for i, input := range []struct {
+		date    *cal.IFCDate
+		sunrise time.Time
+		sunset  time.Time
+	}{
+		{
+			cal.DateAt(time.Date(2024, time.June, 20, 0, 0, 0, 0, time.UTC)),
+			time.Date(2024, time.June, 20, 3, 54, 0, 0, tzHelsinki),
+			time.Date(2024, time.June, 20, 22, 50, 0, 0, tzHelsinki),
+		},
+		{
+			cal.DateAt(time.Date(2024, time.December, 21, 0, 0, 0, 0, time.UTC)),
+			time.Date(2024, time.December, 21, 9, 24, 0, 0, tzHelsinki),
+			time.Date(2024, time.December, 21, 15, 13, 0, 0, tzHelsinki),
+		},
+	} {
+		day := helsinki.SolarDay(input.date)
+		if diff := day.Sunrise.Sub(input.sunrise); diff < -3*time.Minute || diff > 3*time.Minute {
+			t.Errorf("%d: Expected sunrise at %s but found %s\n", i, input.sunrise, day.Sunrise)
+		}
+		if diff := day.Sunset.Sub(input.sunset); diff < -3*time.Minute || diff > 3*time.Minute {
+			t.Errorf("%d: Expected sunset at %s but found %s\n", i, input.sunset, day.Sunset)
+		}
+		if !day.CivilDawn.Before(day.Sunrise) || !day.CivilDusk.After(day.Sunset) {
+			t.Errorf("%d: Expected civil twilight around the day but found %s and %s\n", i, day.CivilDawn, day.CivilDusk)
+		}
+		if day.DayLength != day.Sunset.Sub(day.Sunrise) {
+			t.Errorf("%d: Expected a day length of %s but found %s\n", i, day.Sunset.Sub(day.Sunrise), day.DayLength)
+		}
+	}
+}
+
+func TestPolarDays(t *testing.T) {
+	tromso := &cal.Place{Latitude: 69.6492, Longitude: 18.9553, TimeZone: time.UTC}
+
+	summer := tromso.SolarDay(cal.NewIFCDate(2024, cal.Sol, 4))
+	if !summer.PolarDay || summer.DayLength != 24*time.Hour || !summer.Sunrise.IsZero() {
+		t.Errorf("Expected midnight sun but found %+v\n", summer)
+	}
+
+	winter := tromso.SolarDay(cal.NewIFCDate(2024, cal.December, 27))
+	if !winter.PolarNight || winter.DayLength != 0 || winter.CivilDawn.IsZero() {
+		t.Errorf("Expected polar night with civil twilight but found %+v\n", winter)
+	}
+}
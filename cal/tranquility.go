@@ -0,0 +1,241 @@
+package cal
+
+import (
+	"fmt"
+	"time"
+)
+
+// The Tranquility calendar counts years from the first Moon landing. Each
+// year has 13 months of 28 days, every one of them starting on a Friday,
+// followed by Armstrong Day, which is not part of any week or month. In
+// leap years Aldrin Day is inserted between the 27th and 28th of
+// Hippocrates. Moon Landing Day itself belongs to no year and separates
+// the years Before Tranquility (negative) from those After Tranquility.
+const (
+	aldrinDayOfYear         = 7*daysInMonth + 28 // Falls on Gregorian February 29.
+	tranquilityYearStartDay = 21                 // Years start on 21 July.
+)
+
+var moonLandingFixed = FixedFromTime(time.Date(1969, time.July, 20, 0, 0, 0, 0, time.UTC))
+
+type TranquilityMonth int
+
+const (
+	Archimedes TranquilityMonth = 1 + iota
+	Brahe
+	Copernicus
+	Darwin
+	Einstein
+	Faraday
+	Galileo
+	Hippocrates
+	Imhotep
+	Jung
+	Kepler
+	Lavoisier
+	Mendel
+)
+
+var tranquilityMonthNames = []string{
+	"Archimedes",
+	"Brahe",
+	"Copernicus",
+	"Darwin",
+	"Einstein",
+	"Faraday",
+	"Galileo",
+	"Hippocrates",
+	"Imhotep",
+	"Jung",
+	"Kepler",
+	"Lavoisier",
+	"Mendel",
+}
+
+func (m TranquilityMonth) String() string {
+	if m > 0 && m <= MonthsInYear {
+		return tranquilityMonthNames[int(m)-1]
+	}
+	return fmt.Sprintf("%%!TranquilityMonth(%d)", int(m))
+}
+
+type tranquilityDay int
+
+const (
+	ordinaryDay tranquilityDay = iota
+	armstrongDay
+	aldrinDay
+	moonLandingDay
+)
+
+// TranquilityDate has a zero Month and Day on Armstrong Day, Aldrin Day
+// and Moon Landing Day, which are outside of the months.
+type TranquilityDate struct {
+	Year  int
+	Month TranquilityMonth
+	Day   int
+	kind  tranquilityDay
+}
+
+func NewTranquilityDate(year int, month TranquilityMonth, day int) *TranquilityDate {
+	return &TranquilityDate{Year: year, Month: month, Day: day}
+}
+
+func ArmstrongDay(year int) *TranquilityDate {
+	return &TranquilityDate{Year: year, kind: armstrongDay}
+}
+
+func AldrinDay(year int) *TranquilityDate {
+	return &TranquilityDate{Year: year, kind: aldrinDay}
+}
+
+func MoonLandingDay() *TranquilityDate {
+	return &TranquilityDate{kind: moonLandingDay}
+}
+
+func TranquilityDateAt(t time.Time) *TranquilityDate {
+	return TranquilityDateFromFixed(FixedFromTime(t))
+}
+
+func TranquilityDateFromFixed(fixed int) *TranquilityDate {
+	if fixed == moonLandingFixed {
+		return MoonLandingDay()
+	}
+
+	year := tranquilityGregorianYear(fixed) - 1968
+	if fixed < moonLandingFixed {
+		year--
+	}
+
+	dayOfYear := fixed - tranquilityNewYear(year) + 1
+	if IsTranquilityLeapYear(year) {
+		if dayOfYear == aldrinDayOfYear {
+			return AldrinDay(year)
+		}
+		if dayOfYear > aldrinDayOfYear {
+			dayOfYear--
+		}
+	}
+
+	if dayOfYear > MonthsInYear*daysInMonth {
+		return ArmstrongDay(year)
+	}
+
+	monthOrdinal := (dayOfYear - 1) / daysInMonth
+	return NewTranquilityDate(year, TranquilityMonth(monthOrdinal+1), dayOfYear-monthOrdinal*daysInMonth)
+}
+
+// tranquilityGregorianYear returns the Gregorian year in which the
+// Tranquility year containing the fixed day began.
+func tranquilityGregorianYear(fixed int) int {
+	t := TimeFromFixed(fixed)
+	if t.Month() < time.July || t.Month() == time.July && t.Day() < tranquilityYearStartDay {
+		return t.Year() - 1
+	}
+	return t.Year()
+}
+
+// tranquilityNewYear returns the fixed day of the first of Archimedes.
+func tranquilityNewYear(year int) int {
+	gregorianYear := 1968 + year
+	if year < 0 {
+		gregorianYear++
+	}
+	return FixedFromTime(time.Date(gregorianYear, time.July, tranquilityYearStartDay, 0, 0, 0, 0, time.UTC))
+}
+
+func (d *TranquilityDate) Fixed() int {
+	switch d.kind {
+	case moonLandingDay:
+		return moonLandingFixed
+	case armstrongDay:
+		return tranquilityNewYear(d.Year) + DaysInTranquilityYear(d.Year) - 1
+	case aldrinDay:
+		return tranquilityNewYear(d.Year) + aldrinDayOfYear - 1
+	}
+
+	dayOfYear := (int(d.Month)-1)*daysInMonth + d.Day
+	if IsTranquilityLeapYear(d.Year) && dayOfYear >= aldrinDayOfYear {
+		dayOfYear++
+	}
+	return tranquilityNewYear(d.Year) + dayOfYear - 1
+}
+
+func (d *TranquilityDate) ToUTCTime() time.Time {
+	return TimeFromFixed(d.Fixed())
+}
+
+func (d *TranquilityDate) ToIFCDate() *IFCDate {
+	return DateFromFixed(d.Fixed())
+}
+
+func (d *TranquilityDate) Equal(other *TranquilityDate) bool {
+	return d.Day == other.Day && d.Month == other.Month && d.Year == other.Year && d.kind == other.kind
+}
+
+func (d *TranquilityDate) IsArmstrongDay() bool {
+	return d.kind == armstrongDay
+}
+
+func (d *TranquilityDate) IsAldrinDay() bool {
+	return d.kind == aldrinDay
+}
+
+func (d *TranquilityDate) IsMoonLandingDay() bool {
+	return d.kind == moonLandingDay
+}
+
+// Weekday returns LeapDay for Aldrin Day and YearDay for Armstrong Day and
+// Moon Landing Day, as they are outside of the week like their IFC
+// counterparts.
+func (d *TranquilityDate) Weekday() Weekday {
+	switch d.kind {
+	case aldrinDay:
+		return LeapDay
+	case armstrongDay, moonLandingDay:
+		return YearDay
+	}
+	return Weekday((d.Day - 1 + int(Friday)) % DaysInWeek)
+}
+
+func (d *TranquilityDate) String() string {
+	switch d.kind {
+	case moonLandingDay:
+		return "Moon Landing Day"
+	case armstrongDay:
+		return fmt.Sprintf("Armstrong Day %s", FormatTranquilityYear(d.Year))
+	case aldrinDay:
+		return fmt.Sprintf("Aldrin Day %s", FormatTranquilityYear(d.Year))
+	}
+	return fmt.Sprintf("%d %s %s", d.Day, d.Month, FormatTranquilityYear(d.Year))
+}
+
+func FormatTranquilityYear(year int) string {
+	if year < 0 {
+		return fmt.Sprintf("%d BT", -year)
+	}
+	return fmt.Sprintf("%d AT", year)
+}
+
+// IsTranquilityLeapYear reports whether the year contains a Gregorian
+// leap day, which becomes Aldrin Day.
+func IsTranquilityLeapYear(year int) bool {
+	gregorianYear := 1969 + year
+	if year < 0 {
+		gregorianYear++
+	}
+	return IsLeapYear(gregorianYear)
+}
+
+// DaysInTranquilityYear includes Armstrong Day and Aldrin Day. The year
+// before Moon Landing Day has no Armstrong Day.
+func DaysInTranquilityYear(year int) int {
+	days := MonthsInYear*daysInMonth + 1
+	if IsTranquilityLeapYear(year) {
+		days++
+	}
+	if year == -1 {
+		days--
+	}
+	return days
+}
@@ -0,0 +1,80 @@
+package cal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestTranquilityDateConversion(t *testing.T) {
+	for i, input := range []struct {
+		tranquilityDate *cal.TranquilityDate
+		time            time.Time
+	}{
+		{
+			cal.MoonLandingDay(),
+			time.Date(1969, time.July, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewTranquilityDate(1, cal.Archimedes, 1),
+			time.Date(1969, time.July, 21, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewTranquilityDate(-1, cal.Mendel, 28),
+			time.Date(1969, time.July, 19, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.ArmstrongDay(-2),
+			time.Date(1968, time.July, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewTranquilityDate(53, cal.Faraday, 18),
+			time.Date(2021, time.December, 25, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewTranquilityDate(55, cal.Hippocrates, 27),
+			time.Date(2024, time.February, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.AldrinDay(55),
+			time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.NewTranquilityDate(55, cal.Hippocrates, 28),
+			time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			cal.ArmstrongDay(55),
+			time.Date(2024, time.July, 20, 0, 0, 0, 0, time.UTC),
+		},
+	} {
+		date := cal.TranquilityDateAt(input.time)
+		if !date.Equal(input.tranquilityDate) {
+			t.Errorf("%d: Expected %s but found %s\n", i, input.tranquilityDate, date)
+		}
+
+		timeStamp := input.tranquilityDate.ToUTCTime()
+		if !timeStamp.Equal(input.time) {
+			t.Errorf("%d: Expected %+v but found %+v\n", i, input.time, timeStamp)
+		}
+	}
+}
+
+func TestTranquilityWeekdays(t *testing.T) {
+	for i, input := range []struct {
+		tranquilityDate *cal.TranquilityDate
+		weekday         cal.Weekday
+	}{
+		{cal.NewTranquilityDate(1, cal.Archimedes, 1), cal.Friday},
+		{cal.NewTranquilityDate(1, cal.Archimedes, 7), cal.Thursday},
+		{cal.NewTranquilityDate(53, cal.Mendel, 28), cal.Thursday},
+		{cal.AldrinDay(55), cal.LeapDay},
+		{cal.ArmstrongDay(55), cal.YearDay},
+	} {
+		weekday := input.tranquilityDate.Weekday()
+		if weekday != input.weekday {
+			t.Errorf("%d: Expected %s but found %s\n", i, input.weekday, weekday)
+		}
+	}
+}
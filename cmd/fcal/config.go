@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+// config holds the settings read from the configuration file, which has
+// one key = value setting per line and # comments:
+//
+//	latitude = 60.17
+//	longitude = 24.94
+//	timezone = Europe/Helsinki
+type config struct {
+	latitude  *float64
+	longitude *float64
+	timezone  string
+}
+
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "fcal", "config")
+}
+
+// loadConfig reads a configuration file. A missing file at the default
+// path is not an error.
+func loadConfig(path string, required bool) (*config, error) {
+	conf := &config{}
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) && !required {
+			return conf, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf(tr("%s:%d: expected key = value"), path, lineNum)
+		}
+
+		key, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		switch key {
+		case "latitude", "longitude":
+			coordinate, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf(tr("%s:%d: invalid %s %s"), path, lineNum, key, value)
+			}
+			if key == "latitude" {
+				conf.latitude = &coordinate
+			} else {
+				conf.longitude = &coordinate
+			}
+		case "timezone":
+			conf.timezone = value
+		default:
+			return nil, fmt.Errorf(tr("%s:%d: unknown setting %s"), path, lineNum, key)
+		}
+	}
+	return conf, scanner.Err()
+}
+
+// parseConfig combines the location flags with the configuration file,
+// the flags taking precedence.
+func parseConfig(flags *Flags) (*config, error) {
+	path, required := flags.ConfigFile, true
+	if path == "" {
+		path, required = defaultConfigPath(), false
+	}
+	conf := &config{}
+	if path != "" {
+		var err error
+		if conf, err = loadConfig(path, required); err != nil {
+			return nil, err
+		}
+	}
+
+	if flags.Latitude != nil {
+		conf.latitude = flags.Latitude
+	}
+	if flags.Longitude != nil {
+		conf.longitude = flags.Longitude
+	}
+	if flags.TimeZone != "" {
+		conf.timezone = flags.TimeZone
+	}
+	return conf, nil
+}
+
+// location returns the time zone of the configuration, or the local time
+// zone if none is set.
+func (conf *config) location() (*time.Location, error) {
+	if conf.timezone == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(conf.timezone)
+}
+
+// place returns the place of the configuration, or nil if no coordinates
+// are set.
+func (conf *config) place() (*cal.Place, error) {
+	if conf.latitude == nil && conf.longitude == nil {
+		return nil, nil
+	}
+	if conf.latitude == nil || conf.longitude == nil {
+		return nil, errors.New(tr("both latitude and longitude are needed"))
+	}
+	if *conf.latitude < -90 || *conf.latitude > 90 || *conf.longitude < -180 || *conf.longitude > 180 {
+		return nil, fmt.Errorf(tr("invalid coordinates %g, %g"), *conf.latitude, *conf.longitude)
+	}
+
+	loc, err := conf.location()
+	if err != nil {
+		return nil, err
+	}
+	return &cal.Place{Latitude: *conf.latitude, Longitude: *conf.longitude, TimeZone: loc}, nil
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeConfig(t *testing.T, contents string) string {
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("Error writing config: %s", err)
+	}
+	return path
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := writeConfig(t, "# Helsinki\nlatitude = 60.17\n\nlongitude=24.94\ntimezone = Europe/Helsinki\n")
+	conf, err := loadConfig(path, true)
+	if err != nil {
+		t.Fatalf("Error loading config: %s", err)
+	}
+	if conf.latitude == nil || *conf.latitude != 60.17 {
+		t.Errorf("Expected latitude 60.17 but found %v\n", conf.latitude)
+	}
+	if conf.longitude == nil || *conf.longitude != 24.94 {
+		t.Errorf("Expected longitude 24.94 but found %v\n", conf.longitude)
+	}
+	if conf.timezone != "Europe/Helsinki" {
+		t.Errorf("Expected time zone Europe/Helsinki but found %s\n", conf.timezone)
+	}
+}
+
+func TestLoadMissingConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing")
+	if conf, err := loadConfig(path, false); err != nil || conf.latitude != nil || conf.timezone != "" {
+		t.Errorf("Expected an empty config but found %+v, %v\n", conf, err)
+	}
+	if _, err := loadConfig(path, true); err == nil {
+		t.Errorf("Expected an error for a missing required config\n")
+	}
+}
+
+func TestInvalidConfigs(t *testing.T) {
+	for i, contents := range []string{
+		"latitude 60.17\n",
+		"latitude = north\n",
+		"altitude = 12\n",
+	} {
+		if _, err := loadConfig(writeConfig(t, contents), true); err == nil {
+			t.Errorf("%d: Expected an error for config %q\n", i, contents)
+		}
+	}
+}
+
+func TestTimeZoneFlagWithoutCoordinates(t *testing.T) {
+	flags := &Flags{ConfigFile: writeConfig(t, "timezone = Europe/Helsinki\n"), TimeZone: "Asia/Tokyo"}
+	conf, err := parseConfig(flags)
+	if err != nil {
+		t.Fatalf("Error parsing config: %s", err)
+	}
+	if place, err := conf.place(); place != nil || err != nil {
+		t.Errorf("Expected no place but found %+v, %v\n", place, err)
+	}
+	loc, err := conf.location()
+	if err != nil {
+		t.Fatalf("Error loading time zone: %s", err)
+	}
+	if loc.String() != "Asia/Tokyo" {
+		t.Errorf("Expected time zone Asia/Tokyo but found %s\n", loc)
+	}
+	if _, offset := time.Date(2024, time.January, 1, 0, 0, 0, 0, loc).Zone(); offset != 9*60*60 {
+		t.Errorf("Expected an offset of 9 hours but found %d seconds\n", offset)
+	}
+}
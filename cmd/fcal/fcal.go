@@ -1,9 +1,15 @@
 package main
 
 import (
+	"bufio"
 	"fmt"
+	"io"
+	"log"
 	"math"
+	"os"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/Lateks/cotsworth/cal"
 	fcalFmt "github.com/Lateks/cotsworth/fmt"
@@ -11,31 +17,34 @@ import (
 
 type Flags struct {
 	ParseGregorian          bool
+	ParseJulian             bool
 	ShowSurroundingMonths   int
 	ShowRelationToGregorian bool
+	RelatedCalendar         string
+	HoloceneEra             bool
+	FiscalPattern           string
+	FiscalStart             string
+	FiscalWeekday           string
+	CalendarSpec            string
+	ReformAdoption          string
+	ReformRule              string
+	Holidays                string
+	Feasts                  string
+	ShowSeasons             bool
+	ShowMoonPhases          bool
+	Latitude                *float64
+	Longitude               *float64
+	TimeZone                string
+	ConfigFile              string
+	Locale                  string
+	Format                  string
+	Paper                   string
+	Landscape               bool
+	Notes                   bool
 }
 
-func displayMonth(monthDate *cal.IFCDate, highlightDate *cal.IFCDate) {
-	monthLines := fcalFmt.MonthToLines(monthDate.Year, monthDate.Month, highlightDate)
-	fmt.Println(strings.Join(monthLines, "\n"))
-}
-
-func displayMonthsOnLine(numMonths int, startMonth *cal.IFCDate, highlightDate *cal.IFCDate) {
-	if numMonths < 1 {
-		return
-	}
-	if numMonths == 1 {
-		displayMonth(startMonth, highlightDate)
-		return
-	}
-
-	months := make([][]string, numMonths)
-	month := startMonth
-	for m := 0; m < numMonths; m++ {
-		months[m] = fcalFmt.MonthToLines(month.Year, month.Month, highlightDate)
-		month = month.PlusMonths(1)
-	}
-
+func displaySideBySide(months [][]string) {
+	numMonths := len(months)
 	for i := 0; i < len(months[0]); i++ {
 		for j := 0; j < numMonths-1; j++ {
 			fmt.Print(months[j][i])
@@ -44,36 +53,193 @@ func displayMonthsOnLine(numMonths int, startMonth *cal.IFCDate, highlightDate *
 	}
 }
 
-func displayMonthWithGregorianCal(month *cal.IFCDate, highlightDate *cal.IFCDate) {
-	lines := fcalFmt.MonthToLinesWithGregorian(month.Year, month.Month, highlightDate)
+func displayMonthWithRelatedCal(month *cal.IFCDate, highlightDate *cal.IFCDate, relatedCalendar fcalFmt.DayLabeler, opts *fcalFmt.Options) {
+	lines := fcalFmt.MonthToLinesWithCalendar(month.Year, month.Month, highlightDate, relatedCalendar, opts)
 	for _, line := range lines {
 		fmt.Println(line)
 	}
 }
 
+func displayHolidays(out io.Writer, numMonths int, startMonth *cal.IFCDate, holidays cal.HolidayProvider, opts *fcalFmt.Options) {
+	if holidays == nil {
+		return
+	}
+
+	var lines []string
+	for m := 0; m < numMonths; m++ {
+		month := startMonth.PlusMonths(m)
+		lines = append(lines, fcalFmt.HolidaysToLines(holidays, month.Year, month.Month, opts)...)
+	}
+	if len(lines) > 0 {
+		fmt.Fprintln(out, strings.Join(lines, "\n"))
+		fmt.Fprintln(out)
+	}
+}
+
 const maxMonthsPerLine = 3
 
-func displayCompactCalendar(numMonths int, startMonth *cal.IFCDate, highlightDate *cal.IFCDate) {
+func displayCompactCalendar(numMonths int, startMonth *cal.IFCDate, highlightDate *cal.IFCDate, holidays cal.HolidayProvider, opts *fcalFmt.Options) {
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	renderer := fcalFmt.TextRenderer{MonthsPerRow: maxMonthsPerLine}
+	if holidays == nil {
+		if err := renderer.WriteMonths(out, startMonth, numMonths, highlightDate, opts); err != nil {
+			log.Fatalln(err)
+		}
+		return
+	}
 	for numMonths > 0 {
 		monthsToDisplay := int(math.Min(maxMonthsPerLine, float64(numMonths)))
-		displayMonthsOnLine(monthsToDisplay, startMonth, highlightDate)
+		if err := renderer.WriteMonths(out, startMonth, monthsToDisplay, highlightDate, opts); err != nil {
+			log.Fatalln(err)
+		}
+		displayHolidays(out, monthsToDisplay, startMonth, holidays, opts)
 		startMonth = startMonth.PlusMonths(monthsToDisplay)
 		numMonths -= monthsToDisplay
 	}
 }
 
-func displayRelationToGregorian(numMonths int, startMonth *cal.IFCDate, highlightDate *cal.IFCDate) {
+func displayRelation(numMonths int, startMonth *cal.IFCDate, highlightDate *cal.IFCDate, relatedCalendar fcalFmt.DayLabeler, holidays cal.HolidayProvider, opts *fcalFmt.Options) {
 	for month := 0; month < numMonths; month++ {
-		displayMonthWithGregorianCal(startMonth.PlusMonths(month), highlightDate)
+		displayMonthWithRelatedCal(startMonth.PlusMonths(month), highlightDate, relatedCalendar, opts)
 		fmt.Println()
+		displayHolidays(os.Stdout, 1, startMonth.PlusMonths(month), holidays, opts)
+	}
+}
+
+// displayRendered writes the months of a command in the format selected
+// with -format. Related dates are included with -r, and always on wall
+// calendars.
+func displayRendered(command *command) {
+	var labeler fcalFmt.DayLabeler
+	if command.labelDays {
+		labeler = command.relatedCalendar
+	}
+	months := fcalFmt.LayoutMonths(command.firstMonth, command.numMonths, command.highlightDay, labeler, command.options)
+	if err := command.renderer.Render(os.Stdout, months); err != nil {
+		log.Fatalln(err)
 	}
 }
 
+func displayFiscalYear(command *fiscalCommand) {
+	lines := fcalFmt.FiscalYearToLines(command.calendar, command.year, command.highlightDay, command.options)
+	fmt.Println(strings.Join(lines, "\n"))
+}
+
+func displaySpecCalendar(command *specCommand) {
+	calendar := command.calendar
+	year, month := command.year, command.firstMonth
+	for numMonths := command.numMonths; numMonths > 0; {
+		monthsToDisplay := int(math.Min(maxMonthsPerLine, float64(numMonths)))
+		months := make([][]string, monthsToDisplay)
+		for m := range months {
+			months[m] = fcalFmt.PerennialMonthToLines(calendar, year, month, command.highlightDay)
+			if month++; month > calendar.MonthsInYear() {
+				year, month = year+1, 1
+			}
+		}
+		displaySideBySide(months)
+		numMonths -= monthsToDisplay
+	}
+}
+
+func displayReformTransition(command *reformCommand) {
+	lines := fcalFmt.ReformTransitionToLines(command.reform, command.highlightDay, command.options)
+	fmt.Println(strings.Join(lines, "\n"))
+	fmt.Printf(tr("\nThe IFC takes effect on %s, the day after %s.\n"),
+		fcalFmt.ReformDate(command.reform.DateAt(command.reform.EffectiveDate()), command.options),
+		fcalFmt.ReformDate(command.reform.DateAt(command.reform.LastGregorianDate()), command.options))
+}
+
+func displayFeasts(command *feastCommand) {
+	feasts := append(command.computus.MovableFeasts(command.year),
+		cal.MidsummerEve(command.year), cal.MidsummerDay(command.year))
+	sort.SliceStable(feasts, func(i, j int) bool {
+		return feasts[i].Gregorian.Before(feasts[j].Gregorian)
+	})
+	fmt.Println(strings.Join(fcalFmt.FeastsToLines(feasts, command.options), "\n"))
+}
+
+func displayFiringTimes(command *scheduleCommand) {
+	times := make([]time.Time, 0, command.count)
+	after := command.after
+	for len(times) < command.count {
+		next := command.schedule.Next(after)
+		if next.IsZero() {
+			break
+		}
+		times = append(times, next)
+		after = next
+	}
+	if len(times) == 0 {
+		fmt.Printf(tr("The schedule %s never fires.\n"), command.schedule)
+		return
+	}
+	fmt.Println(strings.Join(fcalFmt.FiringTimesToLines(times, command.options), "\n"))
+}
+
+func displayQueryResults(command *queryCommand) {
+	dates := command.query.Dates(command.from, command.to)
+	if len(dates) == 0 {
+		fmt.Println(tr("No matching dates."))
+		return
+	}
+	fmt.Println(strings.Join(fcalFmt.DatesToLines(dates, command.options), "\n"))
+}
+
+func displayDay(command *dayCommand) {
+	var solarDay *cal.SolarDay
+	if command.place != nil {
+		solarDay = command.place.SolarDay(command.date)
+	}
+	fmt.Println(strings.Join(fcalFmt.DayToLines(command.date, solarDay, command.options), "\n"))
+}
+
 func Execute(flags *Flags, args []string) {
+	locale, ok := parseLocale(flags)
+	if !ok {
+		log.Fatalf(tr("Unknown locale %s\n"), flags.Locale)
+	}
+	currentLocale = locale
+
+	if len(args) > 0 && args[0] == "day" {
+		displayDay(parseDayArgs(flags, args[1:]))
+		return
+	}
+	if len(args) > 0 && args[0] == "next" {
+		displayFiringTimes(parseScheduleArgs(flags, args[1:]))
+		return
+	}
+	if len(args) > 0 && args[0] == "find" {
+		displayQueryResults(parseQueryArgs(flags, args[1:]))
+		return
+	}
+	if flags.ReformAdoption != "" {
+		displayReformTransition(parseReformArgs(flags))
+		return
+	}
+	if flags.CalendarSpec != "" {
+		displaySpecCalendar(parseSpecArgs(flags, args))
+		return
+	}
+	if flags.Feasts != "" {
+		displayFeasts(parseFeastArgs(flags, args))
+		return
+	}
+	if flags.FiscalPattern != "" {
+		displayFiscalYear(parseFiscalArgs(flags, args))
+		return
+	}
+
 	command := parseArgs(flags, args)
+	if command.renderer != nil {
+		displayRendered(command)
+		return
+	}
 	if command.showRelationToGregorian {
-		displayRelationToGregorian(command.numMonths, command.firstMonth, command.highlightDay)
+		displayRelation(command.numMonths, command.firstMonth, command.highlightDay, command.relatedCalendar, command.holidays, command.options)
 	} else {
-		displayCompactCalendar(command.numMonths, command.firstMonth, command.highlightDay)
+		displayCompactCalendar(command.numMonths, command.firstMonth, command.highlightDay, command.holidays, command.options)
 	}
 }
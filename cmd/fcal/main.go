@@ -2,21 +2,117 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"os"
+	"strconv"
 )
 
+// optionalFloat is a float flag that records whether it was set.
+type optionalFloat struct {
+	value *float64
+}
+
+func (f *optionalFloat) String() string {
+	if f.value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*f.value, 'f', -1, 64)
+}
+
+func (f *optionalFloat) Set(text string) error {
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return err
+	}
+	f.value = &value
+	return nil
+}
+
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintf(out, "Usage:\n")
+	fmt.Fprintf(out, "  %s [flags] [year [month [day]]]\n", os.Args[0])
+	fmt.Fprintf(out, "  %s [flags] next 'minute hour day month weekday' [count]\n", os.Args[0])
+	fmt.Fprintf(out, "  %s [flags] find 'month=... day=... weekday=...' [year[..year]]\n", os.Args[0])
+	fmt.Fprintf(out, "  %s [flags] day [year month day]\n", os.Args[0])
+	fmt.Fprintf(out, "\nFlags:\n")
+	flag.PrintDefaults()
+}
+
 func main() {
 	var relationToGregorian bool
 	var gregorian bool
+	var julian bool
+	var relatedCalendar string
+	var holocene bool
+	var fiscalPattern, fiscalStart, fiscalWeekday string
+	var calendarSpec string
+	var reformAdoption, reformRule string
+	var holidays string
+	var feasts string
+	var seasons bool
+	var moon bool
+	var latitude, longitude optionalFloat
+	var timezone, configFile string
+	var locale string
+	var format string
+	var paper string
+	var landscape, notes bool
 	var monthsToDisplay int
 	flag.IntVar(&monthsToDisplay, "n", 1, "number of months to display")
 	flag.BoolVar(&gregorian, "g", false, "parse the parameters as Gregorian calendar date (requires year, month and day parameters)")
-	flag.BoolVar(&relationToGregorian, "r", false, "show the fixed calendar in relation to the Gregorian calendar")
+	flag.BoolVar(&julian, "j", false, "parse the parameters as Julian calendar date (requires year, month and day parameters; years before the common era are negative, such as -- -43 3 15 for 44 BCE) and show the Julian calendar in relation view")
+	flag.BoolVar(&relationToGregorian, "r", false, "show the fixed calendar in relation to the Gregorian calendar (or the one selected with -c)")
+	flag.StringVar(&relatedCalendar, "c", "", "calendar to show in relation view: gregorian, julian, coptic, ethiopian, islamic, hebrew, persian or french (default gregorian, or julian with -j)")
+	flag.BoolVar(&holocene, "holocene", false, "display years in the Holocene Era (year + 10000)")
+	flag.StringVar(&fiscalPattern, "fiscal", "", "show the periods of a fiscal year (optional year parameter): 13, 445, 454, 544, nrf or ifc")
+	flag.StringVar(&fiscalStart, "fiscal-start", "01-01", "start date (MM-DD) of fiscal years with -fiscal 13, 445, 454 or 544")
+	flag.StringVar(&fiscalWeekday, "fiscal-weekday", "sunday", "weekday nearest to the start date on which fiscal years start")
+	flag.StringVar(&calendarSpec, "spec", "", "display a perennial calendar: ifc, world or the path of a JSON calendar spec")
+	flag.StringVar(&reformAdoption, "reform", "", "show the transition month of a country adopting the IFC on the given date (YYYY-MM-DD)")
+	flag.StringVar(&reformRule, "reform-rule", "immediate", "when the IFC takes effect after the adoption date: immediate, month or year")
+	flag.StringVar(&holidays, "H", "", "mark and list holidays: comma-separated list of fi, us, uk and ifc")
+	flag.StringVar(&feasts, "feasts", "", "list the movable feasts of a year (optional year parameter) by the gregorian or orthodox computus")
+	flag.BoolVar(&seasons, "seasons", false, "mark equinoxes and solstices with ~ and list them")
+	flag.BoolVar(&moon, "moon", false, "mark the principal phases of the Moon and list them")
+	flag.Var(&latitude, "lat", "latitude for the day view, positive to the north")
+	flag.Var(&longitude, "lon", "longitude for the day view, positive to the east")
+	flag.StringVar(&timezone, "tz", "", "time zone for the day view, such as Europe/Helsinki (default local)")
+	flag.StringVar(&configFile, "config", "", "configuration file with latitude, longitude and timezone settings (default $XDG_CONFIG_HOME/fcal/config)")
+	flag.StringVar(&locale, "locale", "", "language of month names, weekdays and messages: en, fi, de, fr, es or ja (default from LC_ALL, LC_TIME or LANG)")
+	flag.StringVar(&format, "format", "text", "output format of the calendar: text, json, html or svg (with -r, html shows the related dates in subscripts; listed days are named in the day cells)")
+	flag.StringVar(&paper, "paper", "a4", "paper size of svg calendars: a4, a3, letter or tabloid")
+	flag.BoolVar(&landscape, "landscape", false, "turn the paper of svg calendars sideways")
+	flag.BoolVar(&notes, "notes", false, "leave space for notes below each month of svg calendars")
+	flag.Usage = usage
 	flag.Parse()
 
 	flags := &Flags{
 		ParseGregorian:          gregorian,
+		ParseJulian:             julian,
 		ShowSurroundingMonths:   monthsToDisplay - 1,
 		ShowRelationToGregorian: relationToGregorian,
+		RelatedCalendar:         relatedCalendar,
+		HoloceneEra:             holocene,
+		FiscalPattern:           fiscalPattern,
+		FiscalStart:             fiscalStart,
+		FiscalWeekday:           fiscalWeekday,
+		CalendarSpec:            calendarSpec,
+		ReformAdoption:          reformAdoption,
+		ReformRule:              reformRule,
+		Holidays:                holidays,
+		Feasts:                  feasts,
+		ShowSeasons:             seasons,
+		ShowMoonPhases:          moon,
+		Latitude:                latitude.value,
+		Longitude:               longitude.value,
+		TimeZone:                timezone,
+		ConfigFile:              configFile,
+		Locale:                  locale,
+		Format:                  format,
+		Paper:                   paper,
+		Landscape:               landscape,
+		Notes:                   notes,
 	}
 
 	Execute(flags, flag.Args())
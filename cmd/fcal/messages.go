@@ -0,0 +1,232 @@
+package main
+
+import (
+	"os"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+// currentLocale is the language of the calendars, the month names in
+// arguments and the messages. Execute sets it from the flags and the
+// environment.
+var currentLocale = cal.EnglishLocale()
+
+// parseLocale returns the locale given with -locale or, failing that, the
+// one named by LC_ALL, LC_TIME or LANG. Locales that are not built in
+// fall back to English, unless they are given with -locale.
+func parseLocale(flags *Flags) (*cal.Locale, bool) {
+	if flags.Locale != "" {
+		return cal.LookupLocale(flags.Locale)
+	}
+	for _, variable := range []string{"LC_ALL", "LC_TIME", "LANG"} {
+		if name := os.Getenv(variable); name != "" {
+			if locale, ok := cal.LookupLocale(name); ok {
+				return locale, true
+			}
+			break
+		}
+	}
+	return cal.EnglishLocale(), true
+}
+
+var translations = map[string]map[string]string{
+	"fi": {
+		"invalid year value: %d":                                                           "virheellinen vuosi: %d",
+		"invalid month value %d (use %d-%d)":                                               "virheellinen kuukausi %d (käytä arvoja %d-%d)",
+		"unknown month name: %s":                                                           "tuntematon kuukauden nimi: %s",
+		"invalid Gregorian month value: %d (use 1-12)":                                     "virheellinen gregoriaanisen kalenterin kuukausi: %d (käytä arvoja 1-12)",
+		"Sol is not a Gregorian month":                                                     "sol ei ole gregoriaanisen kalenterin kuukausi",
+		"invalid day value: %d":                                                            "virheellinen päivä: %d",
+		"invalid day in month %s: %d (use 1-%d)":                                           "virheellinen päivä kuussa %s: %d (käytä arvoja 1-%d)",
+		"Error parsing argument %s: %s\n":                                                  "Virhe argumentissa %s: %s\n",
+		"Gregorian and Julian parsing modes cannot be used together":                       "Gregoriaanista ja juliaanista tilaa ei voi käyttää yhdessä",
+		"Gregorian parsing mode expects year, month and day parameters":                    "Gregoriaaninen tila vaatii vuoden, kuukauden ja päivän",
+		"Julian parsing mode expects year, month and day parameters":                       "Juliaaninen tila vaatii vuoden, kuukauden ja päivän",
+		"Unknown calendar %s\n":                                                            "Tuntematon kalenteri %s\n",
+		"No matching dates.":                                                               "Ei osuvia päiviä.",
+		"The schedule %s never fires.\n":                                                   "Ajastus %s ei laukea koskaan.\n",
+		"Unknown paper size %s (use a4, a3, letter or tabloid)\n":                          "Tuntematon paperikoko %s (käytä a4, a3, letter tai tabloid)\n",
+		"Unknown format %s (use text, json, html or svg)\n":                                "Tuntematon muoto %s (käytä text, json, html tai svg)\n",
+		"Unknown holiday set %s (use fi, us, uk or ifc)\n":                                 "Tuntematon pyhäpäiväjoukko %s (käytä fi, us, uk tai ifc)\n",
+		"invalid weekday: %s":                                                              "virheellinen viikonpäivä: %s",
+		"unknown fiscal calendar: %s (use 13, 445, 454, 544, nrf or ifc)":                  "tuntematon tilikalenteri: %s (käytä 13, 445, 454, 544, nrf tai ifc)",
+		"invalid fiscal year start %s (use MM-DD)":                                         "virheellinen tilikauden alku %s (käytä muotoa KK-PP)",
+		"unknown computus: %s (use gregorian or orthodox)":                                 "tuntematon pääsiäisen laskutapa: %s (käytä gregorian tai orthodox)",
+		"next expects a schedule expression and an optional number of firing times":        "next vaatii ajastuslausekkeen ja valinnaisen laukaisujen määrän",
+		"Invalid number of firing times: %s\n":                                             "Virheellinen laukaisujen määrä: %s\n",
+		"invalid year range: %s":                                                           "virheellinen vuosiväli: %s",
+		"find expects a query such as 'weekday=Fri day=13' and an optional range of years": "find vaatii kyselyn, kuten 'weekday=Fri day=13', ja valinnaisen vuosivälin",
+		"Error reading location: %s\n":                                                     "Virhe sijainnin lukemisessa: %s\n",
+		"day expects no parameters or year, month and day parameters":                      "day vaatii joko ei parametreja tai vuoden, kuukauden ja päivän",
+		"Error loading calendar %s: %s\n":                                                  "Virhe kalenterin %s lataamisessa: %s\n",
+		"unknown transition rule: %s (use immediate, month or year)":                       "tuntematon siirtymäsääntö: %s (käytä immediate, month tai year)",
+		"Invalid adoption date %s (use YYYY-MM-DD)\n":                                      "Virheellinen käyttöönottopäivä %s (käytä muotoa VVVV-KK-PP)\n",
+		"%s:%d: expected key = value":                                                      "%s:%d: odotettiin muotoa avain = arvo",
+		"%s:%d: invalid %s %s":                                                             "%s:%d: virheellinen %s %s",
+		"%s:%d: unknown setting %s":                                                        "%s:%d: tuntematon asetus %s",
+		"both latitude and longitude are needed":                                           "sekä leveys- että pituusaste tarvitaan",
+		"invalid coordinates %g, %g":                                                       "virheelliset koordinaatit %g, %g",
+		"\nThe IFC takes effect on %s, the day after %s.\n":                                "\nIFC tulee voimaan %s, %s jälkeisenä päivänä.\n",
+	},
+	"de": {
+		"invalid year value: %d":                                                           "ungültiges Jahr: %d",
+		"invalid month value %d (use %d-%d)":                                               "ungültiger Monat %d (erlaubt sind %d-%d)",
+		"unknown month name: %s":                                                           "unbekannter Monatsname: %s",
+		"invalid Gregorian month value: %d (use 1-12)":                                     "ungültiger gregorianischer Monat: %d (erlaubt sind 1-12)",
+		"Sol is not a Gregorian month":                                                     "Sol ist kein gregorianischer Monat",
+		"invalid day value: %d":                                                            "ungültiger Tag: %d",
+		"invalid day in month %s: %d (use 1-%d)":                                           "ungültiger Tag im Monat %s: %d (erlaubt sind 1-%d)",
+		"Error parsing argument %s: %s\n":                                                  "Fehler im Argument %s: %s\n",
+		"Gregorian and Julian parsing modes cannot be used together":                       "Der gregorianische und der julianische Modus können nicht zusammen verwendet werden",
+		"Gregorian parsing mode expects year, month and day parameters":                    "Der gregorianische Modus erwartet Jahr, Monat und Tag",
+		"Julian parsing mode expects year, month and day parameters":                       "Der julianische Modus erwartet Jahr, Monat und Tag",
+		"Unknown calendar %s\n":                                                            "Unbekannter Kalender %s\n",
+		"No matching dates.":                                                               "Keine passenden Tage.",
+		"The schedule %s never fires.\n":                                                   "Der Zeitplan %s wird nie ausgelöst.\n",
+		"Unknown paper size %s (use a4, a3, letter or tabloid)\n":                          "Unbekanntes Papierformat %s (erlaubt sind a4, a3, letter oder tabloid)\n",
+		"Unknown format %s (use text, json, html or svg)\n":                                "Unbekanntes Format %s (erlaubt sind text, json, html oder svg)\n",
+		"Unknown holiday set %s (use fi, us, uk or ifc)\n":                                 "Unbekannte Feiertagsliste %s (erlaubt sind fi, us, uk oder ifc)\n",
+		"invalid weekday: %s":                                                              "ungültiger Wochentag: %s",
+		"unknown fiscal calendar: %s (use 13, 445, 454, 544, nrf or ifc)":                  "unbekannter Geschäftskalender: %s (erlaubt sind 13, 445, 454, 544, nrf oder ifc)",
+		"invalid fiscal year start %s (use MM-DD)":                                         "ungültiger Beginn des Geschäftsjahres %s (erwartet wird MM-TT)",
+		"unknown computus: %s (use gregorian or orthodox)":                                 "unbekannte Osterrechnung: %s (erlaubt sind gregorian oder orthodox)",
+		"next expects a schedule expression and an optional number of firing times":        "next erwartet einen Zeitplan und optional die Anzahl der Zeitpunkte",
+		"Invalid number of firing times: %s\n":                                             "Ungültige Anzahl von Zeitpunkten: %s\n",
+		"invalid year range: %s":                                                           "ungültiger Jahresbereich: %s",
+		"find expects a query such as 'weekday=Fri day=13' and an optional range of years": "find erwartet eine Abfrage wie 'weekday=Fri day=13' und optional einen Jahresbereich",
+		"Error reading location: %s\n":                                                     "Fehler beim Lesen des Standorts: %s\n",
+		"day expects no parameters or year, month and day parameters":                      "day erwartet keine Parameter oder Jahr, Monat und Tag",
+		"Error loading calendar %s: %s\n":                                                  "Fehler beim Laden des Kalenders %s: %s\n",
+		"unknown transition rule: %s (use immediate, month or year)":                       "unbekannte Übergangsregel: %s (erlaubt sind immediate, month oder year)",
+		"Invalid adoption date %s (use YYYY-MM-DD)\n":                                      "Ungültiges Einführungsdatum %s (erwartet wird JJJJ-MM-TT)\n",
+		"%s:%d: expected key = value":                                                      "%s:%d: erwartet wird Schlüssel = Wert",
+		"%s:%d: invalid %s %s":                                                             "%s:%d: ungültiger Wert für %s: %s",
+		"%s:%d: unknown setting %s":                                                        "%s:%d: unbekannte Einstellung %s",
+		"both latitude and longitude are needed":                                           "Breiten- und Längengrad werden beide benötigt",
+		"invalid coordinates %g, %g":                                                       "ungültige Koordinaten %g, %g",
+		"\nThe IFC takes effect on %s, the day after %s.\n":                                "\nDer IFC gilt ab %s, dem Tag nach %s.\n",
+	},
+	"fr": {
+		"invalid year value: %d":                                                           "année invalide : %d",
+		"invalid month value %d (use %d-%d)":                                               "mois invalide %d (utilisez %d-%d)",
+		"unknown month name: %s":                                                           "nom de mois inconnu : %s",
+		"invalid Gregorian month value: %d (use 1-12)":                                     "mois grégorien invalide : %d (utilisez 1-12)",
+		"Sol is not a Gregorian month":                                                     "sol n'est pas un mois grégorien",
+		"invalid day value: %d":                                                            "jour invalide : %d",
+		"invalid day in month %s: %d (use 1-%d)":                                           "jour invalide dans le mois %s : %d (utilisez 1-%d)",
+		"Error parsing argument %s: %s\n":                                                  "Erreur dans l'argument %s : %s\n",
+		"Gregorian and Julian parsing modes cannot be used together":                       "Les modes grégorien et julien ne peuvent pas être utilisés ensemble",
+		"Gregorian parsing mode expects year, month and day parameters":                    "Le mode grégorien attend une année, un mois et un jour",
+		"Julian parsing mode expects year, month and day parameters":                       "Le mode julien attend une année, un mois et un jour",
+		"Unknown calendar %s\n":                                                            "Calendrier inconnu %s\n",
+		"No matching dates.":                                                               "Aucune date correspondante.",
+		"The schedule %s never fires.\n":                                                   "La planification %s ne se déclenche jamais.\n",
+		"Unknown paper size %s (use a4, a3, letter or tabloid)\n":                          "Format de papier inconnu %s (utilisez a4, a3, letter ou tabloid)\n",
+		"Unknown format %s (use text, json, html or svg)\n":                                "Format inconnu %s (utilisez text, json, html ou svg)\n",
+		"Unknown holiday set %s (use fi, us, uk or ifc)\n":                                 "Jeu de jours fériés inconnu %s (utilisez fi, us, uk ou ifc)\n",
+		"invalid weekday: %s":                                                              "jour de la semaine invalide : %s",
+		"unknown fiscal calendar: %s (use 13, 445, 454, 544, nrf or ifc)":                  "calendrier fiscal inconnu : %s (utilisez 13, 445, 454, 544, nrf ou ifc)",
+		"invalid fiscal year start %s (use MM-DD)":                                         "début d'exercice invalide %s (utilisez MM-JJ)",
+		"unknown computus: %s (use gregorian or orthodox)":                                 "comput inconnu : %s (utilisez gregorian ou orthodox)",
+		"next expects a schedule expression and an optional number of firing times":        "next attend une expression de planification et un nombre facultatif de déclenchements",
+		"Invalid number of firing times: %s\n":                                             "Nombre de déclenchements invalide : %s\n",
+		"invalid year range: %s":                                                           "plage d'années invalide : %s",
+		"find expects a query such as 'weekday=Fri day=13' and an optional range of years": "find attend une requête telle que 'weekday=Fri day=13' et une plage d'années facultative",
+		"Error reading location: %s\n":                                                     "Erreur de lecture de la position : %s\n",
+		"day expects no parameters or year, month and day parameters":                      "day n'attend aucun paramètre, ou une année, un mois et un jour",
+		"Error loading calendar %s: %s\n":                                                  "Erreur de chargement du calendrier %s : %s\n",
+		"unknown transition rule: %s (use immediate, month or year)":                       "règle de transition inconnue : %s (utilisez immediate, month ou year)",
+		"Invalid adoption date %s (use YYYY-MM-DD)\n":                                      "Date d'adoption invalide %s (utilisez AAAA-MM-JJ)\n",
+		"%s:%d: expected key = value":                                                      "%s:%d : clé = valeur attendu",
+		"%s:%d: invalid %s %s":                                                             "%s:%d : valeur de %s invalide : %s",
+		"%s:%d: unknown setting %s":                                                        "%s:%d : paramètre inconnu %s",
+		"both latitude and longitude are needed":                                           "la latitude et la longitude sont toutes deux nécessaires",
+		"invalid coordinates %g, %g":                                                       "coordonnées invalides %g, %g",
+		"\nThe IFC takes effect on %s, the day after %s.\n":                                "\nL'IFC entre en vigueur le %s, le lendemain du %s.\n",
+	},
+	"es": {
+		"invalid year value: %d":                                                           "año no válido: %d",
+		"invalid month value %d (use %d-%d)":                                               "mes no válido %d (use %d-%d)",
+		"unknown month name: %s":                                                           "nombre de mes desconocido: %s",
+		"invalid Gregorian month value: %d (use 1-12)":                                     "mes gregoriano no válido: %d (use 1-12)",
+		"Sol is not a Gregorian month":                                                     "sol no es un mes gregoriano",
+		"invalid day value: %d":                                                            "día no válido: %d",
+		"invalid day in month %s: %d (use 1-%d)":                                           "día no válido en el mes %s: %d (use 1-%d)",
+		"Error parsing argument %s: %s\n":                                                  "Error en el argumento %s: %s\n",
+		"Gregorian and Julian parsing modes cannot be used together":                       "Los modos gregoriano y juliano no se pueden usar juntos",
+		"Gregorian parsing mode expects year, month and day parameters":                    "El modo gregoriano espera año, mes y día",
+		"Julian parsing mode expects year, month and day parameters":                       "El modo juliano espera año, mes y día",
+		"Unknown calendar %s\n":                                                            "Calendario desconocido %s\n",
+		"No matching dates.":                                                               "No hay fechas coincidentes.",
+		"The schedule %s never fires.\n":                                                   "La programación %s nunca se ejecuta.\n",
+		"Unknown paper size %s (use a4, a3, letter or tabloid)\n":                          "Tamaño de papel desconocido %s (use a4, a3, letter o tabloid)\n",
+		"Unknown format %s (use text, json, html or svg)\n":                                "Formato desconocido %s (use text, json, html o svg)\n",
+		"Unknown holiday set %s (use fi, us, uk or ifc)\n":                                 "Conjunto de festivos desconocido %s (use fi, us, uk o ifc)\n",
+		"invalid weekday: %s":                                                              "día de la semana no válido: %s",
+		"unknown fiscal calendar: %s (use 13, 445, 454, 544, nrf or ifc)":                  "calendario fiscal desconocido: %s (use 13, 445, 454, 544, nrf o ifc)",
+		"invalid fiscal year start %s (use MM-DD)":                                         "inicio de año fiscal no válido %s (use MM-DD)",
+		"unknown computus: %s (use gregorian or orthodox)":                                 "cómputo desconocido: %s (use gregorian u orthodox)",
+		"next expects a schedule expression and an optional number of firing times":        "next espera una expresión de programación y un número opcional de ejecuciones",
+		"Invalid number of firing times: %s\n":                                             "Número de ejecuciones no válido: %s\n",
+		"invalid year range: %s":                                                           "rango de años no válido: %s",
+		"find expects a query such as 'weekday=Fri day=13' and an optional range of years": "find espera una consulta como 'weekday=Fri day=13' y un rango de años opcional",
+		"Error reading location: %s\n":                                                     "Error al leer la ubicación: %s\n",
+		"day expects no parameters or year, month and day parameters":                      "day no espera parámetros, o bien año, mes y día",
+		"Error loading calendar %s: %s\n":                                                  "Error al cargar el calendario %s: %s\n",
+		"unknown transition rule: %s (use immediate, month or year)":                       "regla de transición desconocida: %s (use immediate, month o year)",
+		"Invalid adoption date %s (use YYYY-MM-DD)\n":                                      "Fecha de adopción no válida %s (use AAAA-MM-DD)\n",
+		"%s:%d: expected key = value":                                                      "%s:%d: se esperaba clave = valor",
+		"%s:%d: invalid %s %s":                                                             "%s:%d: valor de %s no válido: %s",
+		"%s:%d: unknown setting %s":                                                        "%s:%d: ajuste desconocido %s",
+		"both latitude and longitude are needed":                                           "se necesitan tanto la latitud como la longitud",
+		"invalid coordinates %g, %g":                                                       "coordenadas no válidas %g, %g",
+		"\nThe IFC takes effect on %s, the day after %s.\n":                                "\nEl IFC entra en vigor el %s, el día después del %s.\n",
+	},
+	"ja": {
+		"invalid year value: %d":                                                           "無効な年です: %d",
+		"invalid month value %d (use %d-%d)":                                               "無効な月です: %d (%d-%d を使用してください)",
+		"unknown month name: %s":                                                           "不明な月名です: %s",
+		"invalid Gregorian month value: %d (use 1-12)":                                     "無効なグレゴリオ暦の月です: %d (1-12 を使用してください)",
+		"Sol is not a Gregorian month":                                                     "ソル月はグレゴリオ暦の月ではありません",
+		"invalid day value: %d":                                                            "無効な日です: %d",
+		"invalid day in month %s: %d (use 1-%d)":                                           "%s の無効な日です: %d (1-%d を使用してください)",
+		"Error parsing argument %s: %s\n":                                                  "引数 %s の解析エラー: %s\n",
+		"Gregorian and Julian parsing modes cannot be used together":                       "グレゴリオ暦モードとユリウス暦モードは同時に使用できません",
+		"Gregorian parsing mode expects year, month and day parameters":                    "グレゴリオ暦モードには年、月、日が必要です",
+		"Julian parsing mode expects year, month and day parameters":                       "ユリウス暦モードには年、月、日が必要です",
+		"Unknown calendar %s\n":                                                            "不明な暦です: %s\n",
+		"No matching dates.":                                                               "一致する日付はありません。",
+		"The schedule %s never fires.\n":                                                   "スケジュール %s は実行されません。\n",
+		"Unknown paper size %s (use a4, a3, letter or tabloid)\n":                          "不明な用紙サイズです: %s (a4、a3、letter、tabloid を使用してください)\n",
+		"Unknown format %s (use text, json, html or svg)\n":                                "不明な形式です: %s (text、json、html、svg を使用してください)\n",
+		"Unknown holiday set %s (use fi, us, uk or ifc)\n":                                 "不明な祝日セットです: %s (fi、us、uk、ifc を使用してください)\n",
+		"invalid weekday: %s":                                                              "無効な曜日です: %s",
+		"unknown fiscal calendar: %s (use 13, 445, 454, 544, nrf or ifc)":                  "不明な会計暦です: %s (13、445、454、544、nrf、ifc を使用してください)",
+		"invalid fiscal year start %s (use MM-DD)":                                         "無効な会計年度の開始日です: %s (MM-DD を使用してください)",
+		"unknown computus: %s (use gregorian or orthodox)":                                 "不明な復活祭の計算法です: %s (gregorian、orthodox を使用してください)",
+		"next expects a schedule expression and an optional number of firing times":        "next にはスケジュール式と任意の実行回数が必要です",
+		"Invalid number of firing times: %s\n":                                             "無効な実行回数です: %s\n",
+		"invalid year range: %s":                                                           "無効な年の範囲です: %s",
+		"find expects a query such as 'weekday=Fri day=13' and an optional range of years": "find には 'weekday=Fri day=13' のようなクエリと任意の年の範囲が必要です",
+		"Error reading location: %s\n":                                                     "位置情報の読み込みエラー: %s\n",
+		"day expects no parameters or year, month and day parameters":                      "day には引数を指定しないか、年、月、日を指定してください",
+		"Error loading calendar %s: %s\n":                                                  "暦 %s の読み込みエラー: %s\n",
+		"unknown transition rule: %s (use immediate, month or year)":                       "不明な移行規則です: %s (immediate、month、year を使用してください)",
+		"Invalid adoption date %s (use YYYY-MM-DD)\n":                                      "無効な採用日です: %s (YYYY-MM-DD を使用してください)\n",
+		"%s:%d: expected key = value":                                                      "%s:%d: キー = 値 の形式が必要です",
+		"%s:%d: invalid %s %s":                                                             "%s:%d: 無効な %s です: %s",
+		"%s:%d: unknown setting %s":                                                        "%s:%d: 不明な設定です: %s",
+		"both latitude and longitude are needed":                                           "緯度と経度の両方が必要です",
+		"invalid coordinates %g, %g":                                                       "無効な座標です: %g, %g",
+		"\nThe IFC takes effect on %s, the day after %s.\n":                                "\nIFC は %s (%s の翌日) から施行されます。\n",
+	},
+}
+
+// tr translates a message into the language of currentLocale. Messages
+// without a translation are shown in English.
+func tr(message string) string {
+	if translated, ok := translations[currentLocale.Tag()][message]; ok {
+		return translated
+	}
+	return message
+}
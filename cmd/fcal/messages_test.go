@@ -0,0 +1,71 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+// setLocaleEnv sets the locale variables for the duration of a test.
+func setLocaleEnv(t *testing.T, values map[string]string) {
+	for _, variable := range []string{"LC_ALL", "LC_TIME", "LANG"} {
+		old, ok := os.LookupEnv(variable)
+		os.Setenv(variable, values[variable])
+		t.Cleanup(func() {
+			if ok {
+				os.Setenv(variable, old)
+			} else {
+				os.Unsetenv(variable)
+			}
+		})
+	}
+}
+
+// useLocale sets currentLocale for the duration of a test.
+func useLocale(t *testing.T, locale *cal.Locale) {
+	old := currentLocale
+	currentLocale = locale
+	t.Cleanup(func() { currentLocale = old })
+}
+
+func TestParseLocale(t *testing.T) {
+	for i, input := range []struct {
+		env    map[string]string
+		flag   string
+		locale *cal.Locale
+		ok     bool
+	}{
+		{map[string]string{}, "", cal.EnglishLocale(), true},
+		{map[string]string{"LANG": "fi_FI.UTF-8"}, "", cal.FinnishLocale(), true},
+		{map[string]string{"LC_TIME": "de_DE.UTF-8", "LANG": "fi_FI.UTF-8"}, "", cal.GermanLocale(), true},
+		{map[string]string{"LC_ALL": "ja_JP.UTF-8", "LC_TIME": "de_DE.UTF-8"}, "", cal.JapaneseLocale(), true},
+		{map[string]string{"LC_TIME": "sv_SE.UTF-8", "LANG": "fi_FI.UTF-8"}, "", cal.EnglishLocale(), true},
+		{map[string]string{"LC_TIME": "de_DE.UTF-8"}, "fr", cal.FrenchLocale(), true},
+		{map[string]string{"LANG": "fi_FI.UTF-8"}, "sv", nil, false},
+	} {
+		setLocaleEnv(t, input.env)
+		locale, ok := parseLocale(&Flags{Locale: input.flag})
+		if locale != input.locale || ok != input.ok {
+			t.Errorf("%d: Expected %v, %t but found %v, %t\n", i, input.locale, input.ok, locale, ok)
+		}
+	}
+}
+
+func TestTranslations(t *testing.T) {
+	english := tr("No matching dates.")
+	for tag, messages := range translations {
+		locale, ok := cal.LookupLocale(tag)
+		if !ok {
+			t.Errorf("Translations for unknown locale %s\n", tag)
+			continue
+		}
+		useLocale(t, locale)
+		if translated := tr("No matching dates."); translated == english {
+			t.Errorf("%s: Expected a translation of '%s'\n", tag, english)
+		}
+		if len(messages) != len(translations["fi"]) {
+			t.Errorf("%s: Expected %d messages but found %d\n", tag, len(translations["fi"]), len(messages))
+		}
+	}
+}
@@ -1,13 +1,16 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"os"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/Lateks/cotsworth/cal"
+	fcalFmt "github.com/Lateks/cotsworth/fmt"
 )
 
 type command struct {
@@ -15,28 +18,47 @@ type command struct {
 	firstMonth              *cal.IFCDate
 	highlightDay            *cal.IFCDate
 	showRelationToGregorian bool
+	relatedCalendar         fcalFmt.DayLabeler
+	holidays                cal.HolidayProvider
+	renderer                fcalFmt.Renderer
+	labelDays               bool
+	options                 *fcalFmt.Options
 }
 
 func parseYear(arg string) (int, error) {
 	year, err := strconv.ParseInt(arg, 10, 32)
 	if year < 0 {
-		return 0, fmt.Errorf("invalid year value: %d", year)
+		return 0, fmt.Errorf(tr("invalid year value: %d"), year)
 	}
 	return int(year), err
 }
 
+// parseJulianYear parses a year that may be before the common era, using
+// astronomical numbering: year 0 is 1 BCE and year -43 is 44 BCE.
+func parseJulianYear(arg string) (int, error) {
+	year, err := strconv.ParseInt(arg, 10, 32)
+	return int(year), err
+}
+
+// lookupMonth finds a month by its name in the current locale or in
+// English.
+func lookupMonth(arg string) (cal.IFCMonth, error) {
+	if month, ok := currentLocale.ParseMonth(arg); ok {
+		return month, nil
+	}
+	if month, ok := cal.EnglishLocale().ParseMonth(arg); ok {
+		return month, nil
+	}
+	return cal.January, fmt.Errorf(tr("unknown month name: %s"), arg)
+}
+
 func parseMonth(arg string) (cal.IFCMonth, error) {
 	month, err := strconv.ParseInt(arg, 10, 32)
 	if err != nil {
-		for i, monthName := range cal.LongMonthNames {
-			if strings.ToLower(monthName) == strings.ToLower(arg) {
-				return cal.IFCMonth(i + 1), nil
-			}
-		}
-		return cal.January, err
+		return lookupMonth(arg)
 	}
 	if month < 1 || month > cal.MonthsInYear {
-		return cal.January, fmt.Errorf("invalid month value %d (use %d-%d)", month, 1, cal.MonthsInYear)
+		return cal.January, fmt.Errorf(tr("invalid month value %d (use %d-%d)"), month, 1, cal.MonthsInYear)
 	}
 	return cal.IFCMonth(month), nil
 }
@@ -44,21 +66,19 @@ func parseMonth(arg string) (cal.IFCMonth, error) {
 func parseGregorianMonth(arg string) (time.Month, error) {
 	month, err := strconv.ParseInt(arg, 10, 32)
 	if err != nil {
-		for i, monthName := range cal.LongMonthNames {
-			if monthName == "Sol" {
-				continue
-			}
-			if strings.ToLower(monthName) == strings.ToLower(arg) {
-				if i > 7 {
-					return time.Month(i - 1), nil
-				} else {
-					return time.Month(i), nil
-				}
-			}
+		ifcMonth, err := lookupMonth(arg)
+		switch {
+		case err != nil:
+			return time.January, err
+		case ifcMonth == cal.Sol:
+			return time.January, errors.New(tr("Sol is not a Gregorian month"))
+		case ifcMonth > cal.Sol:
+			return time.Month(ifcMonth - 1), nil
 		}
+		return time.Month(ifcMonth), nil
 	}
 	if month < 1 || month > 12 {
-		return time.January, fmt.Errorf("invalid Gregorian month value: %d (use 1-12)", month)
+		return time.January, fmt.Errorf(tr("invalid Gregorian month value: %d (use 1-12)"), month)
 	}
 	return time.Month(int(month)), nil
 }
@@ -69,44 +89,558 @@ func parseDay(arg string, month cal.IFCMonth, year int) (int, error) {
 		return 0, err
 	}
 	if day < 1 || ((month == cal.December) || (month == cal.June && cal.IsLeapYear(year))) && day > 29 || day > 28 {
-		return 0, fmt.Errorf("invalid day value: %d", day)
+		return 0, fmt.Errorf(tr("invalid day value: %d"), day)
 	}
 
 	return int(day), err
 }
 
-func parseGregorianDay(arg string, month time.Month, year int) (int, error) {
+func parseDayInMonth(arg string, month time.Month, daysInMonth int) (int, error) {
 	day, err := strconv.ParseInt(arg, 10, 32)
 	if err != nil {
 		return 0, err
 	}
-	if day < 1 {
-		return 0, fmt.Errorf("invalid Gregorian day value: %d", day)
+	if day < 1 || int(day) > daysInMonth {
+		return 0, fmt.Errorf(tr("invalid day in month %s: %d (use 1-%d)"), currentLocale.GregorianMonthName(month), day, daysInMonth)
+	}
+
+	return int(day), nil
+}
+
+func parseGregorianDay(arg string, month time.Month, year int) (int, error) {
+	lastOfMonth := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+	return parseDayInMonth(arg, month, lastOfMonth.Day())
+}
+
+func parseJulianDay(arg string, month time.Month, year int) (int, error) {
+	return parseDayInMonth(arg, month, cal.DaysInJulianMonth(year, month))
+}
+
+// relatedCalendars are the calendars whose month names are not
+// localized. The Gregorian and Julian months are named in the current
+// locale.
+var relatedCalendars = map[string]fcalFmt.DayLabeler{
+	"coptic":    fcalFmt.CopticLabel,
+	"ethiopian": fcalFmt.EthiopianLabel,
+	"islamic":   fcalFmt.IslamicLabel,
+	"hebrew":    fcalFmt.HebrewLabel,
+	"persian":   fcalFmt.PersianLabel,
+	"french":    fcalFmt.FrenchRepublicanLabel,
+}
+
+func parseRelatedCalendar(flags *Flags) fcalFmt.DayLabeler {
+	name := strings.ToLower(flags.RelatedCalendar)
+	if name == "" {
+		name = "gregorian"
+		if flags.ParseJulian {
+			name = "julian"
+		}
+	}
+	switch name {
+	case "gregorian":
+		return fcalFmt.GregorianLabeler(currentLocale)
+	case "julian":
+		return fcalFmt.JulianLabeler(currentLocale)
 	}
 
-	switch month {
-	case time.January:
-		if cal.IsLeapYear(year) && day > 29 {
-			return 0, fmt.Errorf("invalid day in month %s: %d (use 1-29)", month, day)
+	labeler, ok := relatedCalendars[name]
+	if !ok {
+		log.Fatalf(tr("Unknown calendar %s\n"), flags.RelatedCalendar)
+	}
+	return labeler
+}
+
+// parseRenderer returns the renderer of the output format, or nil for the
+// terminal views, and whether the format always labels the days with the
+// related calendar.
+func parseRenderer(flags *Flags) (fcalFmt.Renderer, bool) {
+	switch strings.ToLower(flags.Format) {
+	case "", "text":
+		return nil, false
+	case "json":
+		return fcalFmt.JSONRenderer{Indent: "  "}, false
+	case "html":
+		return fcalFmt.HTMLRenderer{Document: true}, false
+	case "svg":
+		paper, ok := fcalFmt.LookupPaperSize(flags.Paper)
+		if !ok {
+			log.Fatalf(tr("Unknown paper size %s (use a4, a3, letter or tabloid)\n"), flags.Paper)
+		}
+		if flags.Landscape {
+			paper = paper.Landscape()
 		}
-		if day > 28 {
-			return 0, fmt.Errorf("invalid day in month %s: %d (use 1-28)", month, day)
+		// Wall calendars show the related dates.
+		return fcalFmt.SVGRenderer{Paper: paper, Notes: flags.Notes}, true
+	}
+	log.Fatalf(tr("Unknown format %s (use text, json, html or svg)\n"), flags.Format)
+	return nil, false
+}
+
+var holidaySets = map[string]cal.HolidayProvider{
+	"fi":  cal.FinnishHolidays,
+	"us":  cal.USFederalHolidays,
+	"uk":  cal.UKBankHolidays,
+	"ifc": cal.IFCObservances,
+}
+
+func parseHolidaySets(flags *Flags) []cal.HolidayProvider {
+	if flags.Holidays == "" {
+		return nil
+	}
+
+	var providers []cal.HolidayProvider
+	for _, name := range strings.Split(flags.Holidays, ",") {
+		provider, ok := holidaySets[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			log.Fatalf(tr("Unknown holiday set %s (use fi, us, uk or ifc)\n"), name)
 		}
-	case time.April, time.June, time.September, time.November:
-		if day > 30 {
-			return 0, fmt.Errorf("invalid day in month %s: %d (use 1-30)", month, day)
+		providers = append(providers, provider)
+	}
+	return providers
+}
+
+// parseListedDays returns the holidays and other observances listed below
+// the month grids, or nil if there are none.
+func parseListedDays(flags *Flags) cal.HolidayProvider {
+	providers := parseHolidaySets(flags)
+	if flags.ShowSeasons {
+		providers = append(providers, cal.SeasonalEventsIn(time.Local))
+	}
+	if flags.ShowMoonPhases {
+		providers = append(providers, cal.MoonPhasesIn(time.Local))
+	}
+
+	switch len(providers) {
+	case 0:
+		return nil
+	case 1:
+		return providers[0]
+	}
+	return cal.CombineHolidays(providers...)
+}
+
+// parseAnnotations returns the listed days with their kinds.
+func parseAnnotations(flags *Flags) []fcalFmt.AnnotatedDays {
+	var annotations []fcalFmt.AnnotatedDays
+	if holidays := parseHolidaySets(flags); holidays != nil {
+		annotations = append(annotations, fcalFmt.AnnotatedDays{Kind: fcalFmt.HolidayAnnotation, Days: cal.CombineHolidays(holidays...)})
+	}
+	if flags.ShowSeasons {
+		annotations = append(annotations, fcalFmt.AnnotatedDays{Kind: fcalFmt.SeasonAnnotation, Days: cal.SeasonalEventsIn(time.Local)})
+	}
+	if flags.ShowMoonPhases {
+		annotations = append(annotations, fcalFmt.AnnotatedDays{Kind: fcalFmt.MoonPhaseAnnotation, Days: cal.MoonPhasesIn(time.Local)})
+	}
+	return annotations
+}
+
+func parseOptions(flags *Flags) *fcalFmt.Options {
+	opts := &fcalFmt.Options{}
+	if flags.HoloceneEra {
+		opts.Era = cal.HoloceneEra
+	}
+	if holidays := parseHolidaySets(flags); holidays != nil {
+		opts.Markers = append(opts.Markers, fcalFmt.HolidayMarker(cal.CombineHolidays(holidays...), '*'))
+	}
+	if flags.ShowSeasons {
+		opts.Markers = append(opts.Markers, fcalFmt.SeasonMarker(time.Local))
+	}
+	if flags.ShowMoonPhases {
+		opts.Markers = append(opts.Markers, fcalFmt.MoonPhaseMarker(time.Local))
+	}
+	opts.Locale = currentLocale
+	return opts
+}
+
+func parseWeekday(arg string) (time.Weekday, error) {
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		name := strings.ToLower(wd.String())
+		if name == strings.ToLower(arg) || name[:3] == strings.ToLower(arg) {
+			return wd, nil
 		}
+	}
+	return time.Sunday, fmt.Errorf(tr("invalid weekday: %s"), arg)
+}
+
+func parseFiscalCalendar(flags *Flags) (*cal.FiscalCalendar, error) {
+	var pattern cal.FiscalPattern
+	switch strings.ToLower(flags.FiscalPattern) {
+	case "nrf":
+		return cal.NRFCalendar, nil
+	case "ifc", "kodak":
+		return cal.KodakCalendar, nil
+	case "13":
+		pattern = cal.ThirteenPeriods
+	case "445":
+		pattern = cal.Pattern445
+	case "454":
+		pattern = cal.Pattern454
+	case "544":
+		pattern = cal.Pattern544
 	default:
-		if day > 31 {
-			return 0, fmt.Errorf("invalid day in month %s: %d (use 1-31)", month, day)
+		return nil, fmt.Errorf(tr("unknown fiscal calendar: %s (use 13, 445, 454, 544, nrf or ifc)"), flags.FiscalPattern)
+	}
+
+	start, err := time.Parse("01-02", flags.FiscalStart)
+	if err != nil {
+		return nil, fmt.Errorf(tr("invalid fiscal year start %s (use MM-DD)"), flags.FiscalStart)
+	}
+	weekday, err := parseWeekday(flags.FiscalWeekday)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cal.FiscalCalendar{
+		Pattern:      pattern,
+		StartMonth:   start.Month(),
+		StartDay:     start.Day(),
+		StartWeekday: weekday,
+		Anchor:       cal.NearestWeekday,
+	}, nil
+}
+
+type fiscalCommand struct {
+	calendar     *cal.FiscalCalendar
+	year         int
+	highlightDay *cal.IFCDate
+	options      *fcalFmt.Options
+}
+
+func parseFiscalArgs(flags *Flags, args []string) *fiscalCommand {
+	calendar, err := parseFiscalCalendar(flags)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	now := time.Now()
+	year := calendar.YearOf(now)
+	if len(args) > 0 {
+		if year, err = parseYear(args[0]); err != nil {
+			logArgParseError(err, args[0])
 		}
 	}
 
-	return int(day), nil
+	return &fiscalCommand{
+		calendar:     calendar,
+		year:         year,
+		highlightDay: cal.DateAt(now),
+		options:      parseOptions(flags),
+	}
+}
+
+func parseComputus(arg string) (cal.Computus, error) {
+	switch strings.ToLower(arg) {
+	case "gregorian", "western":
+		return cal.GregorianComputus, nil
+	case "orthodox", "julian":
+		return cal.OrthodoxComputus, nil
+	}
+	return cal.GregorianComputus, fmt.Errorf(tr("unknown computus: %s (use gregorian or orthodox)"), arg)
+}
+
+type feastCommand struct {
+	computus cal.Computus
+	year     int
+	options  *fcalFmt.Options
+}
+
+func parseFeastArgs(flags *Flags, args []string) *feastCommand {
+	computus, err := parseComputus(flags.Feasts)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	year := time.Now().Year()
+	if len(args) > 0 {
+		if year, err = parseYear(args[0]); err != nil {
+			logArgParseError(err, args[0])
+		}
+	}
+
+	return &feastCommand{
+		computus: computus,
+		year:     year,
+		options:  parseOptions(flags),
+	}
+}
+
+type scheduleCommand struct {
+	schedule *cal.Schedule
+	count    int
+	after    time.Time
+	options  *fcalFmt.Options
+}
+
+const defaultFiringTimes = 5
+
+func parseScheduleArgs(flags *Flags, args []string) *scheduleCommand {
+	if len(args) < 1 {
+		log.Fatalln(tr("next expects a schedule expression and an optional number of firing times"))
+	}
+	schedule, err := cal.ParseSchedule(args[0])
+	if err != nil {
+		logArgParseError(err, args[0])
+	}
+
+	count := defaultFiringTimes
+	if len(args) > 1 {
+		if count, err = strconv.Atoi(args[1]); err != nil || count < 1 {
+			log.Fatalf(tr("Invalid number of firing times: %s\n"), args[1])
+		}
+	}
+
+	return &scheduleCommand{
+		schedule: schedule,
+		count:    count,
+		after:    time.Now(),
+		options:  parseOptions(flags),
+	}
+}
+
+type queryCommand struct {
+	query   *cal.DateQuery
+	from    *cal.IFCDate
+	to      *cal.IFCDate
+	options *fcalFmt.Options
+}
+
+// parseYearRange parses a year or a range of years written as 2020..2030.
+func parseYearRange(arg string) (int, int, error) {
+	bounds := strings.SplitN(arg, "..", 2)
+	first, err := parseYear(bounds[0])
+	if err != nil || len(bounds) == 1 {
+		return first, first, err
+	}
+	last, err := parseYear(bounds[1])
+	if err == nil && last < first {
+		err = fmt.Errorf(tr("invalid year range: %s"), arg)
+	}
+	return first, last, err
+}
+
+func parseQueryArgs(flags *Flags, args []string) *queryCommand {
+	if len(args) < 1 {
+		log.Fatalln(tr("find expects a query such as 'weekday=Fri day=13' and an optional range of years"))
+	}
+	query, err := cal.ParseDateQuery(args[0])
+	if err != nil {
+		logArgParseError(err, args[0])
+	}
+
+	firstYear := cal.DateAt(time.Now()).Year
+	lastYear := firstYear
+	if len(args) > 1 {
+		if firstYear, lastYear, err = parseYearRange(args[1]); err != nil {
+			logArgParseError(err, args[1])
+		}
+	}
+
+	return &queryCommand{
+		query:   query,
+		from:    cal.NewIFCDate(firstYear, cal.January, 1),
+		to:      cal.NewIFCDate(lastYear, cal.December, 29),
+		options: parseOptions(flags),
+	}
+}
+
+type dayCommand struct {
+	date    *cal.IFCDate
+	place   *cal.Place
+	options *fcalFmt.Options
+}
+
+func parseDayArgs(flags *Flags, args []string) *dayCommand {
+	conf, err := parseConfig(flags)
+	var place *cal.Place
+	if err == nil {
+		place, err = conf.place()
+	}
+	loc := time.Local
+	if err == nil {
+		loc, err = conf.location()
+	}
+	if err != nil {
+		log.Fatalf(tr("Error reading location: %s\n"), err)
+	}
+
+	// The time zone decides the current date even without coordinates.
+	date := cal.DateAt(time.Now().In(loc))
+	switch len(args) {
+	case 0:
+	case 3:
+		date = parseDate(flags, args)
+	default:
+		log.Fatalln(tr("day expects no parameters or year, month and day parameters"))
+	}
+
+	return &dayCommand{
+		date:    date,
+		place:   place,
+		options: parseOptions(flags),
+	}
+}
+
+func loadCalendarSpec(name string) (*cal.PerennialCalendar, error) {
+	switch strings.ToLower(name) {
+	case "ifc":
+		return cal.NewPerennialCalendar(cal.IFCSpec)
+	case "world":
+		return cal.NewPerennialCalendar(cal.WorldCalendarSpec)
+	}
+
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return cal.LoadCalendar(file)
+}
+
+func parseSpecMonth(arg string, calendar *cal.PerennialCalendar) (int, error) {
+	month, err := strconv.ParseInt(arg, 10, 32)
+	if err != nil {
+		for m := 1; m <= calendar.MonthsInYear(); m++ {
+			if strings.ToLower(calendar.MonthName(m)) == strings.ToLower(arg) {
+				return m, nil
+			}
+		}
+		return 1, err
+	}
+	if month < 1 || int(month) > calendar.MonthsInYear() {
+		return 1, fmt.Errorf(tr("invalid month value %d (use %d-%d)"), month, 1, calendar.MonthsInYear())
+	}
+	return int(month), nil
+}
+
+type specCommand struct {
+	calendar     *cal.PerennialCalendar
+	numMonths    int
+	year         int
+	firstMonth   int
+	highlightDay *cal.PerennialDate
+}
+
+func parseSpecArgs(flags *Flags, args []string) *specCommand {
+	calendar, err := loadCalendarSpec(flags.CalendarSpec)
+	if err != nil {
+		log.Fatalf(tr("Error loading calendar %s: %s\n"), flags.CalendarSpec, err)
+	}
+
+	today := calendar.DateAt(time.Now())
+	command := &specCommand{
+		calendar:     calendar,
+		numMonths:    1 + flags.ShowSurroundingMonths,
+		year:         today.Year,
+		firstMonth:   today.Month,
+		highlightDay: today,
+	}
+
+	if len(args) > 0 {
+		if command.year, err = parseYear(args[0]); err != nil {
+			logArgParseError(err, args[0])
+		}
+		command.firstMonth = 1
+		command.numMonths = calendar.MonthsInYear() + flags.ShowSurroundingMonths
+	}
+	if len(args) > 1 {
+		if command.firstMonth, err = parseSpecMonth(args[1], calendar); err != nil {
+			logArgParseError(err, args[1])
+		}
+		command.numMonths = 1 + flags.ShowSurroundingMonths
+	}
+
+	if command.numMonths == 1+flags.ShowSurroundingMonths {
+		for i := 0; i < flags.ShowSurroundingMonths/2; i++ {
+			if command.firstMonth--; command.firstMonth < 1 {
+				command.year, command.firstMonth = command.year-1, calendar.MonthsInYear()
+			}
+		}
+	}
+
+	return command
+}
+
+func parseTransitionRule(arg string) (cal.TransitionRule, error) {
+	switch strings.ToLower(arg) {
+	case "immediate":
+		return cal.AdoptImmediately, nil
+	case "month":
+		return cal.AdoptAtNextMonth, nil
+	case "year":
+		return cal.AdoptAtNextYear, nil
+	}
+	return cal.AdoptImmediately, fmt.Errorf(tr("unknown transition rule: %s (use immediate, month or year)"), arg)
+}
+
+type reformCommand struct {
+	reform       *cal.ReformCalendar
+	highlightDay time.Time
+	options      *fcalFmt.Options
+}
+
+func parseReformArgs(flags *Flags) *reformCommand {
+	adoption, err := time.Parse("2006-01-02", flags.ReformAdoption)
+	if err != nil {
+		log.Fatalf(tr("Invalid adoption date %s (use YYYY-MM-DD)\n"), flags.ReformAdoption)
+	}
+	rule, err := parseTransitionRule(flags.ReformRule)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	return &reformCommand{
+		reform:       &cal.ReformCalendar{Adoption: adoption, Rule: rule},
+		highlightDay: cal.TimeFromFixed(cal.FixedFromTime(time.Now())),
+		options:      parseOptions(flags),
+	}
 }
 
 func logArgParseError(err error, arg string) {
-	log.Fatalf("Error parsing argument %s: %s\n", arg, err)
+	log.Fatalf(tr("Error parsing argument %s: %s\n"), arg, err)
+}
+
+// parseDate parses year, month and day arguments as an IFC date, or as a
+// Gregorian or Julian date if requested.
+func parseDate(flags *Flags, args []string) *cal.IFCDate {
+	var date *cal.IFCDate
+	var year, day int
+	var err error
+	yearParser := parseYear
+	if flags.ParseJulian {
+		yearParser = parseJulianYear
+	}
+	if year, err = yearParser(args[0]); err != nil {
+		logArgParseError(err, args[0])
+	}
+	if flags.ParseGregorian {
+		var month time.Month
+		if month, err = parseGregorianMonth(args[1]); err != nil {
+			logArgParseError(err, args[1])
+		}
+		if day, err = parseGregorianDay(args[2], month, year); err != nil {
+			logArgParseError(err, args[2])
+		}
+		date = cal.DateAt(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+	} else if flags.ParseJulian {
+		var month time.Month
+		if month, err = parseGregorianMonth(args[1]); err != nil {
+			logArgParseError(err, args[1])
+		}
+		if day, err = parseJulianDay(args[2], month, year); err != nil {
+			logArgParseError(err, args[2])
+		}
+		date = cal.NewJulianDate(year, month, day).ToIFCDate()
+	} else {
+		var month cal.IFCMonth
+		if month, err = parseMonth(args[1]); err != nil {
+			logArgParseError(err, args[1])
+		}
+		if day, err = parseDay(args[2], month, year); err != nil {
+			logArgParseError(err, args[2])
+		}
+		date = cal.NewIFCDate(year, month, day)
+	}
+	return date
 }
 
 func parseArgs(flags *Flags, args []string) *command {
@@ -116,36 +650,19 @@ func parseArgs(flags *Flags, args []string) *command {
 	numMonthsToShow := 1 + flags.ShowSurroundingMonths
 
 	argCount := len(args)
+	if flags.ParseGregorian && flags.ParseJulian {
+		log.Fatalln(tr("Gregorian and Julian parsing modes cannot be used together"))
+	}
 	if flags.ParseGregorian && argCount < 3 {
-		log.Fatalln("Gregorian parsing mode expects year, month and day parameters")
+		log.Fatalln(tr("Gregorian parsing mode expects year, month and day parameters"))
+	}
+	if flags.ParseJulian && argCount < 3 {
+		log.Fatalln(tr("Julian parsing mode expects year, month and day parameters"))
 	}
 
 	switch argCount {
 	case 3:
-		var year, day int
-		var err error
-		if year, err = parseYear(args[0]); err != nil {
-			logArgParseError(err, args[0])
-		}
-		if flags.ParseGregorian {
-			var month time.Month
-			if month, err = parseGregorianMonth(args[1]); err != nil {
-				logArgParseError(err, args[1])
-			}
-			if day, err = parseGregorianDay(args[2], month, year); err != nil {
-				logArgParseError(err, args[2])
-			}
-			monthSelection = cal.DateAt(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
-		} else {
-			var month cal.IFCMonth
-			if month, err = parseMonth(args[1]); err != nil {
-				logArgParseError(err, args[1])
-			}
-			if day, err = parseDay(args[2], month, year); err != nil {
-				logArgParseError(err, args[2])
-			}
-			monthSelection = cal.NewIFCDate(year, month, day)
-		}
+		monthSelection = parseDate(flags, args)
 		highlightDay = monthSelection
 	case 2:
 		var year int
@@ -175,10 +692,21 @@ func parseArgs(flags *Flags, args []string) *command {
 	}
 
 	startMonth := monthSelection.MinusMonths(flags.ShowSurroundingMonths / 2)
-	return &command{
+	renderer, labelDays := parseRenderer(flags)
+	command := &command{
 		numMonths:               numMonthsToShow,
 		firstMonth:              startMonth,
 		highlightDay:            highlightDay,
 		showRelationToGregorian: flags.ShowRelationToGregorian,
+		relatedCalendar:         parseRelatedCalendar(flags),
+		holidays:                parseListedDays(flags),
+		renderer:                renderer,
+		labelDays:               labelDays || flags.ShowRelationToGregorian,
+		options:                 parseOptions(flags),
+	}
+	// The other formats name the listed days in the day cells.
+	if command.renderer != nil {
+		command.options.Annotations = parseAnnotations(flags)
 	}
+	return command
 }
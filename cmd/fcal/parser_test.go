@@ -0,0 +1,71 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+func TestParseMonthNames(t *testing.T) {
+	useLocale(t, cal.FinnishLocale())
+	for i, input := range []struct {
+		arg       string
+		month     cal.IFCMonth
+		gregorian time.Month
+	}{
+		{"tammikuu", cal.January, time.January},
+		{"Jan", cal.January, time.January},
+		{"sep", cal.September, time.September},
+		{"heinä", cal.July, time.July},
+		{"7", cal.Sol, time.July},
+	} {
+		month, err := parseMonth(input.arg)
+		if err != nil || month != input.month {
+			t.Errorf("%d: Expected %s but found %s, %v\n", i, input.month, month, err)
+		}
+		gregorian, err := parseGregorianMonth(input.arg)
+		if err != nil || gregorian != input.gregorian {
+			t.Errorf("%d: Expected %s but found %s, %v\n", i, input.gregorian, gregorian, err)
+		}
+	}
+}
+
+func TestParseGregorianMonthRejectsSol(t *testing.T) {
+	useLocale(t, cal.EnglishLocale())
+	for _, arg := range []string{"Sol", "sol"} {
+		if _, err := parseGregorianMonth(arg); err == nil {
+			t.Errorf("Expected an error for %s\n", arg)
+		}
+	}
+	if month, err := parseMonth("Sol"); err != nil || month != cal.Sol {
+		t.Errorf("Expected Sol but found %s, %v\n", month, err)
+	}
+}
+
+func TestParseErrorsAreTranslated(t *testing.T) {
+	useLocale(t, cal.FinnishLocale())
+	if _, err := parseGregorianMonth("sol"); err == nil || err.Error() != "sol ei ole gregoriaanisen kalenterin kuukausi" {
+		t.Errorf("Expected a Finnish error but found %v\n", err)
+	}
+	if _, err := parseGregorianDay("31", time.April, 2024); err == nil || err.Error() != "virheellinen päivä kuussa huhtikuu: 31 (käytä arvoja 1-30)" {
+		t.Errorf("Expected a Finnish error but found %v\n", err)
+	}
+}
+
+func TestParseArgsLabelsWallCalendars(t *testing.T) {
+	useLocale(t, cal.EnglishLocale())
+	for i, input := range []struct {
+		flags     *Flags
+		labelDays bool
+	}{
+		{&Flags{Format: "text"}, false},
+		{&Flags{Format: "json"}, false},
+		{&Flags{Format: "json", ShowRelationToGregorian: true}, true},
+		{&Flags{Format: "svg", Paper: "a4"}, true},
+	} {
+		if command := parseArgs(input.flags, []string{"2024"}); command.labelDays != input.labelDays {
+			t.Errorf("%d: Expected labelDays %t but found %t\n", i, input.labelDays, command.labelDays)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package fmt
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+// AnnotatedMonthToLines is like MonthToLines, but labels each week with the
+// date its first day falls on in another calendar. The year of the other
+// calendar at the start of the month is shown next to the weekday header.
+func AnnotatedMonthToLines(year int, month cal.IFCMonth, currentDate *cal.IFCDate, labeler DayLabeler, opts *Options) []string {
+	lines := MonthToLinesWithOptions(year, month, currentDate, opts)
+	firstDay := cal.NewIFCDate(year, month, 1).ToUTCTime()
+
+	annotations := make([]string, len(lines))
+	annotations[1] = strconv.Itoa(labeler(firstDay).Year)
+	for week := 0; week < cal.WeeksInMonth; week++ {
+		label := labeler(firstDay.AddDate(0, 0, week*cal.DaysInWeek))
+		annotations[week+2] = fmt.Sprintf("%2d %s", label.Day, label.Month)
+	}
+
+	annotationWidth := 0
+	for _, annotation := range annotations {
+		if width := displayWidth(annotation); width > annotationWidth {
+			annotationWidth = width
+		}
+	}
+
+	for i := range lines {
+		lines[i] += padRight(annotations[i], annotationWidth) + " "
+	}
+	return lines
+}
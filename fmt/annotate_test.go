@@ -0,0 +1,58 @@
+package fmt_test
+
+import (
+	"testing"
+
+	"github.com/Lateks/cotsworth/cal"
+	"github.com/Lateks/cotsworth/fmt"
+)
+
+func TestAnnotatedMonthFormatting(t *testing.T) {
+	for i, input := range []struct {
+		year    int
+		month   cal.IFCMonth
+		labeler fmt.DayLabeler
+		result  []string
+	}{
+		{
+			2024,
+			cal.March,
+			fmt.IslamicLabel,
+			[]string{
+				"       March 2024                  ",
+				"Su Mo Tu We Th Fr Sa    1445       ",
+				" 1  2  3  4  5  6  7    16 Sha'ban ",
+				" 8  9 10 11 12 13 14    23 Sha'ban ",
+				"15 16 17 18 19 20 21     1 Ramadan ",
+				"22 23 24 25 26 27 28     8 Ramadan ",
+				"                                   ",
+			},
+		},
+		{
+			2022,
+			cal.October,
+			fmt.HebrewLabel,
+			[]string{
+				"      October 2022                    ",
+				"Su Mo Tu We Th Fr Sa    5783          ",
+				" 1  2  3  4  5  6  7    13 Tishrei    ",
+				" 8  9 10 11 12 13 14    20 Tishrei    ",
+				"15 16 17 18 19 20 21    27 Tishrei    ",
+				"22 23 24 25 26 27 28     4 Marheshvan ",
+				"                                      ",
+			},
+		},
+	} {
+		monthFormatting := fmt.AnnotatedMonthToLines(input.year, input.month, nil, input.labeler, nil)
+		if len(input.result) != len(monthFormatting) {
+			t.Fatalf("%d: Expected %d lines in result but found %d (was: %+v)\n",
+				i, len(input.result), len(monthFormatting), monthFormatting)
+		}
+
+		for j := range monthFormatting {
+			if input.result[j] != monthFormatting[j] {
+				t.Errorf("%d: Expected '%s' but found '%s'\n", i, input.result[j], monthFormatting[j])
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package fmt
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+const (
+	weekGridWidth = cal.DaysInWeek * cellWidth
+	weekGridWeeks = 6 // A 30-day month can touch six Gregorian weeks.
+)
+
+// gregorianWeekdayHeader names the weekdays of the continuous week, which
+// share their names with the IFC weekdays from Sunday to Saturday.
+func gregorianWeekdayHeader(locale *cal.Locale) string {
+	buf := make([]byte, 0, weekGridWidth)
+	for wd := cal.Sunday; wd <= cal.Saturday; wd++ {
+		buf = appendWeekdayCell(buf, locale.ShortWeekdayName(wd))
+	}
+	return string(buf)
+}
+
+// weekGridToLines lays out a month whose days follow the continuous
+// seven-day week, like the Unix cal utility. Months shorter than a week,
+// such as the epagomenal months, still produce the full number of lines
+// so that months can be displayed side by side.
+func weekGridToLines(title string, firstDay time.Time, numDays int, highlightDate time.Time, opts *Options) []string {
+	lines := make([]string, 0, weekGridWeeks+2)
+	lines = append(lines, CenterInField(title, weekGridWidth), gregorianWeekdayHeader(opts.locale()))
+
+	offset := int(firstDay.Weekday())
+	line := fmt.Sprintf("%*s", offset*cellWidth, "")
+	date := firstDay
+	for day := 1; day <= numDays; day++ {
+		dayFormat := "%2d "
+		if highlightDate.Equal(date) {
+			dayFormat = "\033[7m%2d\033[0m "
+		}
+		line += fmt.Sprintf(dayFormat, day)
+
+		if (offset+day)%cal.DaysInWeek == 0 {
+			lines = append(lines, line)
+			line = ""
+		}
+		date = date.Add(24 * time.Hour)
+	}
+	if line != "" {
+		lines = append(lines, line+strings.Repeat(" ", weekGridWidth-displayWidth(line)))
+	}
+
+	for len(lines) < cap(lines) {
+		lines = append(lines, fmt.Sprintf("%*s", weekGridWidth, ""))
+	}
+
+	return lines
+}
+
+func CopticMonthToLines(year int, month cal.CopticMonth, currentDate *cal.CopticDate, opts *Options) []string {
+	var highlightDate time.Time
+	if currentDate != nil {
+		highlightDate = currentDate.ToUTCTime()
+	}
+
+	return weekGridToLines(
+		fmt.Sprintf("%s %d", month, year),
+		cal.NewCopticDate(year, month, 1).ToUTCTime(),
+		cal.DaysInCopticMonth(year, month),
+		highlightDate,
+		opts,
+	)
+}
+
+func EthiopianMonthToLines(year int, month cal.EthiopianMonth, currentDate *cal.EthiopianDate, opts *Options) []string {
+	var highlightDate time.Time
+	if currentDate != nil {
+		highlightDate = currentDate.ToUTCTime()
+	}
+
+	return weekGridToLines(
+		fmt.Sprintf("%s %d", month, year),
+		cal.NewEthiopianDate(year, month, 1).ToUTCTime(),
+		cal.DaysInEthiopianMonth(year, month),
+		highlightDate,
+		opts,
+	)
+}
@@ -0,0 +1,75 @@
+package fmt_test
+
+import (
+	"testing"
+
+	"github.com/Lateks/cotsworth/cal"
+	"github.com/Lateks/cotsworth/fmt"
+)
+
+func TestEthiopianMonthFormatting(t *testing.T) {
+	for i, input := range []struct {
+		year         int
+		month        cal.EthiopianMonth
+		highlightDay *cal.EthiopianDate
+		result       []string
+	}{
+		{
+			2016,
+			cal.Meskerem,
+			cal.NewEthiopianDate(2016, cal.Meskerem, 17),
+			[]string{
+				"    Meskerem 2016    ",
+				"Su Mo Tu We Th Fr Sa ",
+				"       1  2  3  4  5 ",
+				" 6  7  8  9 10 11 12 ",
+				"13 14 15 16 \033[7m17\033[0m 18 19 ",
+				"20 21 22 23 24 25 26 ",
+				"27 28 29 30          ",
+				"                     ",
+			},
+		},
+		{
+			2015, // Leap year
+			cal.Pagume,
+			nil,
+			[]string{
+				"     Pagume 2015     ",
+				"Su Mo Tu We Th Fr Sa ",
+				"          1  2  3  4 ",
+				" 5  6                ",
+				"                     ",
+				"                     ",
+				"                     ",
+				"                     ",
+			},
+		},
+	} {
+		monthFormatting := fmt.EthiopianMonthToLines(input.year, input.month, input.highlightDay, nil)
+		if len(input.result) != len(monthFormatting) {
+			t.Fatalf("%d: Expected %d lines in result but found %d (was: %+v)\n",
+				i, len(input.result), len(monthFormatting), monthFormatting)
+		}
+
+		for j := range monthFormatting {
+			if input.result[j] != monthFormatting[j] {
+				t.Errorf("%d: Expected '%s' but found '%s'\n", i, input.result[j], monthFormatting[j])
+			}
+		}
+	}
+}
+
+func TestCopticMonthFormatting(t *testing.T) {
+	monthFormatting := fmt.CopticMonthToLines(1740, cal.PiKogiEnavot, nil, nil)
+	expected := []string{
+		" Pi Kogi Enavot 1740 ",
+		"Su Mo Tu We Th Fr Sa ",
+		"                1  2 ",
+		" 3  4  5             ",
+	}
+	for j := range expected {
+		if expected[j] != monthFormatting[j] {
+			t.Errorf("Expected '%s' but found '%s'\n", expected[j], monthFormatting[j])
+		}
+	}
+}
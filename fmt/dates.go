@@ -0,0 +1,56 @@
+package fmt
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+const (
+	gregorianDayLayout  = "Mon 2006-01-02"
+	gregorianTimeLayout = "Mon 2006-01-02 15:04"
+)
+
+func formatIFCDateWithWeekday(date *cal.IFCDate, opts *Options) string {
+	return fmt.Sprintf("%s %s", opts.locale().WeekdayName(date.Weekday()), formatIFCDate(date, opts))
+}
+
+// alignColumns pads the first column so that the second one lines up.
+func alignColumns(first []string, second []string) []string {
+	width := 0
+	for _, text := range first {
+		if w := displayWidth(text); w > width {
+			width = w
+		}
+	}
+
+	lines := make([]string, len(first))
+	for i := range first {
+		lines[i] = padRight(first[i], width) + "  " + second[i]
+	}
+	return lines
+}
+
+// FiringTimesToLines lists times with the IFC date and weekday first and
+// the Gregorian date after them.
+func FiringTimesToLines(times []time.Time, opts *Options) []string {
+	ifcTimes := make([]string, len(times))
+	gregorianTimes := make([]string, len(times))
+	for i, t := range times {
+		ifcTimes[i] = fmt.Sprintf("%s %s", formatIFCDateWithWeekday(cal.DateAt(t), opts), t.Format("15:04"))
+		gregorianTimes[i] = t.Format(gregorianTimeLayout)
+	}
+	return alignColumns(ifcTimes, gregorianTimes)
+}
+
+// DatesToLines lists IFC dates with their Gregorian equivalents.
+func DatesToLines(dates []*cal.IFCDate, opts *Options) []string {
+	ifcDates := make([]string, len(dates))
+	gregorianDates := make([]string, len(dates))
+	for i, date := range dates {
+		ifcDates[i] = formatIFCDateWithWeekday(date, opts)
+		gregorianDates[i] = date.ToUTCTime().Format(gregorianDayLayout)
+	}
+	return alignColumns(ifcDates, gregorianDates)
+}
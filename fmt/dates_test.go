@@ -0,0 +1,45 @@
+package fmt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+	"github.com/Lateks/cotsworth/fmt"
+)
+
+func TestFiringTimesToLines(t *testing.T) {
+	times := []time.Time{
+		time.Date(2022, time.December, 30, 9, 30, 0, 0, time.UTC),
+		time.Date(2022, time.December, 31, 9, 30, 0, 0, time.UTC),
+	}
+	expected := []string{
+		"Saturday 28 December 2022 09:30  Fri 2022-12-30 09:30",
+		"Year Day 29 December 2022 09:30  Sat 2022-12-31 09:30",
+	}
+
+	lines := fmt.FiringTimesToLines(times, nil)
+	for i := range expected {
+		if lines[i] != expected[i] {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, expected[i], lines[i])
+		}
+	}
+}
+
+func TestDatesToLines(t *testing.T) {
+	dates := []*cal.IFCDate{
+		cal.NewIFCDate(2024, cal.June, 13),
+		cal.NewIFCDate(2024, cal.June, 29),
+	}
+	expected := []string{
+		"Friday 13 June 2024    Sat 2024-06-01",
+		"Leap Day 29 June 2024  Mon 2024-06-17",
+	}
+
+	lines := fmt.DatesToLines(dates, nil)
+	for i := range expected {
+		if lines[i] != expected[i] {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, expected[i], lines[i])
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package fmt
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+// formatClock rounds a time to the nearest minute, as formatDuration
+// does, so that the day length agrees with the times shown.
+func formatClock(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Round(time.Minute).Format("15:04")
+}
+
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	return fmt.Sprintf("%dh %02dm", int(d.Hours()), int(d.Minutes())%60)
+}
+
+// DayToLines shows a date in the IFC and the Gregorian calendar, followed
+// by the times of the Sun if solarDay is not nil.
+func DayToLines(date *cal.IFCDate, solarDay *cal.SolarDay, opts *Options) []string {
+	locale := opts.locale()
+	lines := []string{
+		LongDate(date, opts),
+		locale.GregorianDate(date.ToUTCTime()),
+	}
+	if solarDay == nil {
+		return lines
+	}
+
+	dayLength := formatDuration(solarDay.DayLength)
+	if solarDay.PolarDay {
+		dayLength += " " + locale.Message("(midnight sun)")
+	} else if solarDay.PolarNight {
+		dayLength += " " + locale.Message("(polar night)")
+	}
+
+	labels := []string{"Civil dawn", "Sunrise", "Solar noon", "Sunset", "Civil dusk", "Day length"}
+	for i, label := range labels {
+		labels[i] = locale.Message(label)
+	}
+	return append(append(lines, ""), alignColumns(labels, []string{
+		formatClock(solarDay.CivilDawn),
+		formatClock(solarDay.Sunrise),
+		formatClock(solarDay.SolarNoon),
+		formatClock(solarDay.Sunset),
+		formatClock(solarDay.CivilDusk),
+		dayLength,
+	})...)
+}
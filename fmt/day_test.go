@@ -0,0 +1,72 @@
+package fmt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+	"github.com/Lateks/cotsworth/fmt"
+)
+
+func TestDayToLines(t *testing.T) {
+	date := cal.NewIFCDate(2024, cal.Sol, 4)
+	solarDay := &cal.SolarDay{
+		Date:      date,
+		Sunrise:   time.Date(2024, time.June, 21, 3, 54, 10, 0, time.UTC),
+		SolarNoon: time.Date(2024, time.June, 21, 13, 22, 0, 0, time.UTC),
+		Sunset:    time.Date(2024, time.June, 21, 22, 50, 40, 0, time.UTC),
+		DayLength: 18*time.Hour + 56*time.Minute + 30*time.Second,
+	}
+	expected := []string{
+		"Wednesday, the 4th of Sol, 2024",
+		"Friday, June 21, 2024",
+		"",
+		"Civil dawn  -",
+		"Sunrise     03:54",
+		"Solar noon  13:22",
+		"Sunset      22:51",
+		"Civil dusk  -",
+		"Day length  18h 57m",
+	}
+
+	lines := fmt.DayToLines(date, solarDay, nil)
+	if len(lines) != len(expected) {
+		t.Fatalf("Expected %d lines but found %d\n", len(expected), len(lines))
+	}
+	for i := range expected {
+		if lines[i] != expected[i] {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, expected[i], lines[i])
+		}
+	}
+}
+
+func TestDayToLinesInJapanese(t *testing.T) {
+	date := cal.NewIFCDate(2024, cal.Sol, 4)
+	solarDay := &cal.SolarDay{
+		Date:      date,
+		SolarNoon: time.Date(2024, time.June, 21, 11, 22, 0, 0, time.UTC),
+		DayLength: 24 * time.Hour,
+		PolarDay:  true,
+	}
+	expected := []string{
+		"2024年ソル月4日 水曜日",
+		"2024年6月21日 金曜日",
+		"",
+		"市民薄明の始まり  -",
+		"日の出            -",
+		"南中              11:22",
+		"日の入り          -",
+		"市民薄明の終わり  -",
+		"昼の長さ          24h 00m (白夜)",
+	}
+
+	lines := fmt.DayToLines(date, solarDay, &fmt.Options{Locale: cal.JapaneseLocale()})
+	if len(lines) != len(expected) {
+		t.Fatalf("Expected %d lines but found %d\n", len(expected), len(lines))
+	}
+	for i := range expected {
+		if lines[i] != expected[i] {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, expected[i], lines[i])
+		}
+	}
+}
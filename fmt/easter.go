@@ -0,0 +1,27 @@
+package fmt
+
+import (
+	"fmt"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+// FeastsToLines lists feasts with their Gregorian and IFC dates. The names
+// of the feasts are translated by the locale.
+func FeastsToLines(feasts []cal.Feast, opts *Options) []string {
+	names := make([]string, len(feasts))
+	nameWidth := 0
+	for i, feast := range feasts {
+		names[i] = opts.locale().Message(feast.Name)
+		if width := displayWidth(names[i]); width > nameWidth {
+			nameWidth = width
+		}
+	}
+
+	lines := make([]string, len(feasts))
+	for i, feast := range feasts {
+		lines[i] = fmt.Sprintf("%s %s %s", padRight(names[i], nameWidth),
+			feast.Gregorian.Format(gregorianDateLayout), formatIFCDate(feast.IFC, opts))
+	}
+	return lines
+}
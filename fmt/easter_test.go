@@ -0,0 +1,44 @@
+package fmt_test
+
+import (
+	"testing"
+
+	"github.com/Lateks/cotsworth/cal"
+	"github.com/Lateks/cotsworth/fmt"
+)
+
+func TestFeastsToLines(t *testing.T) {
+	feasts := []cal.Feast{
+		cal.GregorianComputus.Easter(2024),
+		cal.MidsummerEve(2024),
+	}
+	expected := []string{
+		"Easter Sunday 2024-03-31 7 April 2024",
+		"Midsummer Eve 2024-06-21 4 Sol 2024",
+	}
+
+	lines := fmt.FeastsToLines(feasts, &fmt.Options{})
+	for i := range expected {
+		if lines[i] != expected[i] {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, expected[i], lines[i])
+		}
+	}
+}
+
+func TestFeastsToLinesInFinnish(t *testing.T) {
+	feasts := []cal.Feast{
+		cal.GregorianComputus.Easter(2024),
+		cal.MidsummerEve(2024),
+	}
+	expected := []string{
+		"pääsiäispäivä 2024-03-31 7 huhtikuu 2024",
+		"juhannusaatto 2024-06-21 4 sol 2024",
+	}
+
+	lines := fmt.FeastsToLines(feasts, &fmt.Options{Locale: cal.FinnishLocale()})
+	for i := range expected {
+		if lines[i] != expected[i] {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, expected[i], lines[i])
+		}
+	}
+}
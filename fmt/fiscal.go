@@ -0,0 +1,74 @@
+package fmt
+
+import (
+	"fmt"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+const gregorianDateLayout = "2006-01-02"
+
+func formatIFCDate(date *cal.IFCDate, opts *Options) string {
+	return fmt.Sprintf("%d %s %s", date.Day, opts.locale().MonthName(date.Month), opts.era().FormatYear(date.Year))
+}
+
+// FiscalYearToLines lists the periods of a fiscal year with their Gregorian
+// and IFC dates. The period containing highlightDate is shown in reverse
+// video.
+func FiscalYearToLines(calendar *cal.FiscalCalendar, year int, highlightDate *cal.IFCDate, opts *Options) []string {
+	locale := opts.locale()
+	periods := calendar.Periods(year)
+	rows := make([][]string, 0, len(periods)+1)
+	rows = append(rows, []string{locale.Message("Qtr"), locale.Message("Per"), locale.Message("Wks"),
+		locale.Message("Gregorian"), locale.Message("IFC")})
+	for _, period := range periods {
+		rows = append(rows, []string{
+			fmt.Sprintf("Q%d", period.Quarter),
+			fmt.Sprintf("P%d", period.Period),
+			fmt.Sprintf("%3d", period.Weeks),
+			period.Start.Format(gregorianDateLayout) + " - " + period.End.Format(gregorianDateLayout),
+			formatIFCDate(period.StartDate(), opts) + " - " + formatIFCDate(period.EndDate(), opts),
+		})
+	}
+
+	lines := append([]string{
+		fmt.Sprintf(locale.Message("Fiscal year %s (%s, %d weeks)"),
+			opts.era().FormatYear(year), locale.Message(calendar.Pattern.String()), calendar.WeeksInYear(year)),
+	}, alignTable(rows)...)
+	for i, period := range periods {
+		if highlightDate != nil && period.Contains(highlightDate.ToUTCTime()) {
+			lines[i+2] = fmt.Sprintf("\033[7m%s\033[0m", lines[i+2])
+		}
+	}
+	return lines
+}
+
+// alignTable pads every column but the last to the display width of its
+// widest cell and separates the columns with a space.
+func alignTable(rows [][]string) []string {
+	var widths []int
+	for _, row := range rows {
+		for column, cell := range row {
+			if column == len(widths) {
+				widths = append(widths, 0)
+			}
+			if width := displayWidth(cell); width > widths[column] {
+				widths[column] = width
+			}
+		}
+	}
+
+	lines := make([]string, len(rows))
+	for i, row := range rows {
+		line := ""
+		for column, cell := range row {
+			if column < len(row)-1 {
+				line += padRight(cell, widths[column]) + " "
+			} else {
+				line += cell
+			}
+		}
+		lines[i] = line
+	}
+	return lines
+}
@@ -0,0 +1,50 @@
+package fmt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+	"github.com/Lateks/cotsworth/fmt"
+)
+
+func TestFiscalYearFormatting(t *testing.T) {
+	lines := fmt.FiscalYearToLines(cal.NRFCalendar, 2023, cal.NewIFCDate(2023, cal.March, 1), nil)
+	expected := []string{
+		"Fiscal year 2023 (4-5-4, 53 weeks)",
+		"Qtr Per Wks Gregorian               IFC",
+		"Q1  P1    4 2023-01-29 - 2023-02-25 1 February 2023 - 28 February 2023",
+		"\033[7mQ1  P2    5 2023-02-26 - 2023-04-01 1 March 2023 - 7 April 2023\033[0m",
+	}
+	for j := range expected {
+		if expected[j] != lines[j] {
+			t.Errorf("Expected '%s' but found '%s'\n", expected[j], lines[j])
+		}
+	}
+
+	last := lines[len(lines)-1]
+	expectedLast := "Q4  P12   5 2023-12-31 - 2024-02-03 29 December 2023 - 6 February 2024"
+	if last != expectedLast {
+		t.Errorf("Expected '%s' but found '%s'\n", expectedLast, last)
+	}
+}
+
+func TestFiscalYearInJapanese(t *testing.T) {
+	calendar := &cal.FiscalCalendar{
+		Pattern:      cal.ThirteenPeriods,
+		StartMonth:   time.January,
+		StartDay:     1,
+		StartWeekday: time.Sunday,
+	}
+	lines := fmt.FiscalYearToLines(calendar, 2023, nil, &fmt.Options{Locale: cal.JapaneseLocale()})
+	expected := []string{
+		"2023会計年度 (13期間、52週)",
+		"四半期 期間 週  グレゴリオ暦            国際固定暦",
+		"Q1     P1     4 2023-01-01 - 2023-01-28 1 1月 2023 - 28 1月 2023",
+	}
+	for j := range expected {
+		if expected[j] != lines[j] {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", j, expected[j], lines[j])
+		}
+	}
+}
@@ -1,10 +1,9 @@
 package fmt
 
 import (
-	"fmt"
 	"github.com/Lateks/cotsworth/cal"
-	"time"
-	"unicode/utf8"
+	"strconv"
+	"strings"
 )
 
 const (
@@ -13,125 +12,163 @@ const (
 	monthWidth     = daysOnWeekLine * cellWidth
 )
 
+const (
+	highlightStart = "\033[7m"
+	highlightEnd   = "\033[0m"
+
+	// monthLines is the number of lines in the text grid of a month.
+	monthLines = cal.WeeksInMonth + 3
+	// monthBufferSize is enough for the text grid of a month in most
+	// locales, so that rendering seldom needs to grow a buffer.
+	monthBufferSize = monthLines * (4*monthWidth + len(highlightStart) + len(highlightEnd) + 1)
+)
+
 func CenterInField(text string, fieldWidth int) string {
-	textWidth := utf8.RuneCountInString(text)
-	totalPad := fieldWidth - textWidth
+	return string(appendCentered(make([]byte, 0, len(text)+fieldWidth), text, fieldWidth))
+}
+
+func appendCentered(buf []byte, text string, fieldWidth int) []byte {
+	totalPad := fieldWidth - displayWidth(text)
+	if totalPad <= 0 {
+		return append(buf, text...)
+	}
 	leftPad := totalPad / 2
-	return fmt.Sprintf("%-*s", fieldWidth, fmt.Sprintf("%*s", leftPad+textWidth, text))
+	buf = appendSpaces(buf, leftPad)
+	buf = append(buf, text...)
+	return appendSpaces(buf, totalPad-leftPad)
 }
 
-func weekdayHeader(year int, month cal.IFCMonth, weekInMonth int) string {
-	var eighthDay string
-	if weekInMonth == cal.WeeksInMonth {
-		if month == cal.December {
-			eighthDay = fmt.Sprintf("%s ", cal.YearDay.ShortFormat())
-		} else if month == cal.June && cal.IsLeapYear(year) {
-			eighthDay = fmt.Sprintf("%s ", cal.LeapDay.ShortFormat())
+// appendWeekdayHeader names the columns of a week. The header of the last
+// week leaves room for an intercalary day.
+func appendWeekdayHeader(buf []byte, month *MonthLayout, week int) []byte {
+	for _, name := range month.Weekdays {
+		buf = appendWeekdayCell(buf, name)
+	}
+	if week == len(month.Weeks)-1 {
+		if month.IntercalaryDay != "" {
+			buf = appendWeekdayCell(buf, month.IntercalaryDay)
 		} else {
-			eighthDay = "   "
+			buf = appendSpaces(buf, cellWidth)
 		}
 	}
+	return buf
+}
 
-	return fmt.Sprintf("%s %s %s %s %s %s %s ",
-		cal.Sunday.ShortFormat(), cal.Monday.ShortFormat(), cal.Tuesday.ShortFormat(),
-		cal.Wednesday.ShortFormat(), cal.Thursday.ShortFormat(), cal.Friday.ShortFormat(),
-		cal.Saturday.ShortFormat()) + eighthDay
+// appendWeekdayCell pads a short weekday name to the width of a day cell.
+func appendWeekdayCell(buf []byte, name string) []byte {
+	return append(appendPadded(buf, name, cellWidth-1), ' ')
+}
 
+// appendDayNumber writes a day number right-aligned in two columns,
+// followed by a one-column suffix.
+func appendDayNumber(buf []byte, day int, highlighted bool, suffix rune) []byte {
+	if highlighted {
+		buf = append(buf, highlightStart...)
+	}
+	if day < 10 {
+		buf = append(buf, ' ')
+	}
+	buf = strconv.AppendInt(buf, int64(day), 10)
+	if highlighted {
+		buf = append(buf, highlightEnd...)
+	}
+	return appendRune(buf, suffix)
 }
 
-func formatDay(year int, month cal.IFCMonth, dayNum int, dayToHighlight *cal.IFCDate) string {
-	if dayToHighlight != nil && dayToHighlight.Day == dayNum && dayToHighlight.Month == month && dayToHighlight.Year == year {
-		return fmt.Sprintf("\033[7m%2d\033[0m ", dayNum)
+func appendDay(buf []byte, day *DayCell) []byte {
+	marker := day.Marker
+	if marker == 0 {
+		marker = ' '
 	}
-	return fmt.Sprintf("%2d ", dayNum)
+	return appendDayNumber(buf, day.Date.Day, day.Highlighted, marker)
 }
 
-func weekLine(weekNum int, year int, month cal.IFCMonth, highlightDay *cal.IFCDate, equalWidth bool) string {
-	var eighthDay string
-	if weekNum == cal.WeeksInMonth-1 && (month == cal.December || month == cal.June && cal.IsLeapYear(year)) {
-		eighthDay = formatDay(year, month, 29, highlightDay)
+func appendWeekLine(buf []byte, week *WeekLayout, equalWidth bool) []byte {
+	for d := range week.Days {
+		buf = appendDay(buf, &week.Days[d])
+	}
+	if week.Intercalary != nil {
+		buf = appendDay(buf, week.Intercalary)
 	} else if equalWidth {
-		eighthDay = "   "
+		buf = appendSpaces(buf, cellWidth)
 	}
+	return buf
+}
 
-	startDay := weekNum*7 + 1
-	result := formatDay(year, month, startDay, highlightDay)
-	for day := startDay + 1; day < startDay+cal.DaysInWeek; day++ {
-		result += formatDay(year, month, day, highlightDay)
+// appendMonthLine writes a line of the text grid of a month without a
+// line break: the title, the weekday header, the weeks and a blank line.
+func appendMonthLine(buf []byte, month *MonthLayout, line int) []byte {
+	switch week := line - 2; {
+	case line == 0:
+		return appendCentered(buf, month.Title, monthWidth)
+	case line == 1:
+		return appendWeekdayHeader(buf, month, len(month.Weeks)-1)
+	case week < len(month.Weeks):
+		return appendWeekLine(buf, &month.Weeks[week], true)
 	}
-	result += eighthDay
-
-	return result
+	return appendSpaces(buf, monthWidth)
 }
 
-func MonthToLines(year int, month cal.IFCMonth, currentDate *cal.IFCDate) []string {
-	lines := make([]string, 7)
-	title := fmt.Sprintf("%s %d", month, year)
-	lines[0] = CenterInField(title, monthWidth)
-	lines[1] = weekdayHeader(year, month, cal.WeeksInMonth)
-
-	for week := 0; week < cal.WeeksInMonth; week++ {
-		lines[week+2] = weekLine(week, year, month, currentDate, true)
+// monthLayoutToLines renders a month grid as text.
+func monthLayoutToLines(month *MonthLayout) []string {
+	lines := make([]string, monthLines)
+	buf := make([]byte, 0, monthBufferSize/monthLines)
+	for i := range lines {
+		buf = appendMonthLine(buf[:0], month, i)
+		lines[i] = string(buf)
 	}
+	return lines
+}
 
-	lines[6] = fmt.Sprintf("%*s", monthWidth, "")
+func MonthToLines(year int, month cal.IFCMonth, currentDate *cal.IFCDate) []string {
+	return MonthToLinesWithOptions(year, month, currentDate, nil)
+}
 
-	return lines
+func MonthToLinesWithOptions(year int, month cal.IFCMonth, currentDate *cal.IFCDate, opts *Options) []string {
+	return monthLayoutToLines(LayoutMonth(year, month, currentDate, nil, opts))
 }
 
-func formatGregorianChangeOfMonthLine(month time.Month, changeCellIndex int, daysInIFCMonth int) string {
-	monthName := month.String()
-	charsInMonthName := utf8.RuneCountInString(monthName)
+func formatChangeOfMonthLine(monthName string, changeCellIndex int, daysInIFCMonth int) string {
 	leftPad := cellWidth * changeCellIndex
-	rightPad := cellWidth*daysInIFCMonth - leftPad
-	return fmt.Sprintf("%*s%*s", leftPad+charsInMonthName, monthName, rightPad-charsInMonthName, "")
+	return padRight(strings.Repeat(" ", leftPad)+monthName, cellWidth*daysInIFCMonth)
 }
 
-func gregorianMonthToLines(startDate time.Time, numDays int, highlightDate time.Time) (dayNumbers string, weekdays string, monthLine string) {
-	date := startDate
-	for i := 0; i < numDays; i++ {
-		dayFormat := "%2d "
-		if highlightDate.Equal(date) {
-			dayFormat = "\033[7m%2d\033[0m "
-		}
-
-		dayNumbers += fmt.Sprintf(dayFormat, date.Day())
-		weekdays += fmt.Sprintf("%s ", date.Weekday().String()[:2])
-		if date.Day() == 1 {
-			monthLine = formatGregorianChangeOfMonthLine(date.Month(), i, numDays)
+func relatedMonthToLines(month *MonthLayout) (dayNumbers string, weekdays string, monthLine string) {
+	days := month.Days()
+	numbers := make([]byte, 0, len(days)*cellWidth+len(highlightStart)+len(highlightEnd))
+	names := make([]byte, 0, len(days)*cellWidth*2)
+	for i, day := range days {
+		numbers = appendDayNumber(numbers, day.Related.Day, day.Highlighted, ' ')
+		names = appendWeekdayCell(names, month.Weekdays[day.gregorianWeekday()])
+		if day.Related.Day == 1 {
+			monthLine = formatChangeOfMonthLine(day.Related.Month, i, len(days))
 		}
-		date = date.Add(24 * time.Hour)
 	}
 
-	return
+	return string(numbers), string(names), monthLine
 }
 
 func MonthToLinesWithGregorian(year int, month cal.IFCMonth, currentDate *cal.IFCDate) []string {
-	title := fmt.Sprintf("%s %d", month, year)
-	weekdays := ""
-	dayNumbers := ""
-	for i := 0; i < cal.WeeksInMonth; i++ {
-		weekdays += weekdayHeader(year, month, i+1)
-		dayNumbers += weekLine(i, year, month, currentDate, false)
-	}
+	return MonthToLinesWithCalendar(year, month, currentDate, GregorianLabel, nil)
+}
 
-	var gregorianHighlightDay time.Time
-	if currentDate != nil {
-		gregorianHighlightDay = currentDate.ToUTCTime()
+func MonthToLinesWithCalendar(year int, month cal.IFCMonth, currentDate *cal.IFCDate, labeler DayLabeler, opts *Options) []string {
+	layout := LayoutMonth(year, month, currentDate, labeler, opts)
+	weekdays := make([]byte, 0, cal.WeeksInMonth*monthWidth*2)
+	dayNumbers := make([]byte, 0, cal.WeeksInMonth*monthWidth+len(highlightStart)+len(highlightEnd))
+	for w := range layout.Weeks {
+		weekdays = appendWeekdayHeader(weekdays, layout, w)
+		dayNumbers = appendWeekLine(dayNumbers, &layout.Weeks[w], false)
 	}
 
-	gregorianDayNumbers, gregorianWeekdays, gregorianMonthLine := gregorianMonthToLines(
-		cal.NewIFCDate(year, month, 1).ToUTCTime(),
-		cal.DaysInMonth(year, month),
-		gregorianHighlightDay,
-	)
+	relatedDayNumbers, relatedWeekdays, relatedMonthLine := relatedMonthToLines(layout)
 
 	return []string{
-		title,
-		weekdays,
-		dayNumbers,
-		gregorianDayNumbers,
-		gregorianWeekdays,
-		gregorianMonthLine,
+		layout.Title,
+		string(weekdays),
+		string(dayNumbers),
+		relatedDayNumbers,
+		relatedWeekdays,
+		relatedMonthLine,
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/Lateks/cotsworth/cal"
 	"github.com/Lateks/cotsworth/fmt"
 	"math"
+	"strings"
 	"testing"
 )
 
@@ -195,3 +196,74 @@ func TestMonthFormattingWithGregorian(t *testing.T) {
 		}
 	}
 }
+
+func TestMonthFormattingWithJulian(t *testing.T) {
+	monthFormatting := fmt.MonthToLinesWithCalendar(2022, cal.January, nil, fmt.JulianLabel, nil)
+	expected := []string{
+		"January 2022",
+		"Su Mo Tu We Th Fr Sa Su Mo Tu We Th Fr Sa Su Mo Tu We Th Fr Sa Su Mo Tu We Th Fr Sa    ",
+		" 1  2  3  4  5  6  7  8  9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25 26 27 28 ",
+		"19 20 21 22 23 24 25 26 27 28 29 30 31  1  2  3  4  5  6  7  8  9 10 11 12 13 14 15 ",
+		"Sa Su Mo Tu We Th Fr Sa Su Mo Tu We Th Fr Sa Su Mo Tu We Th Fr Sa Su Mo Tu We Th Fr ",
+		"                                       January                                      ",
+	}
+
+	if len(expected) != len(monthFormatting) {
+		t.Fatalf("Expected %d lines in result but found %d (was: %+v)\n",
+			len(expected), len(monthFormatting), monthFormatting)
+	}
+	for j := range expected {
+		if expected[j] != monthFormatting[j] {
+			t.Errorf("Expected '%s' but found '%s'\n", expected[j], monthFormatting[j])
+		}
+	}
+}
+
+func TestMonthFormattingInHoloceneEra(t *testing.T) {
+	opts := &fmt.Options{Era: cal.HoloceneEra}
+	monthFormatting := fmt.MonthToLinesWithOptions(2022, cal.Sol, nil, opts)
+	expected := "      Sol 12022 HE      "
+	if monthFormatting[0] != expected {
+		t.Errorf("Expected '%s' but found '%s'\n", expected, monthFormatting[0])
+	}
+
+	relationFormatting := fmt.MonthToLinesWithCalendar(2022, cal.Sol, nil, fmt.GregorianLabel, opts)
+	expected = "Sol 12022 HE"
+	if relationFormatting[0] != expected {
+		t.Errorf("Expected '%s' but found '%s'\n", expected, relationFormatting[0])
+	}
+}
+
+func TestMonthFormattingInFinnish(t *testing.T) {
+	expected := []string{
+		"     joulukuu 2021      ",
+		"su ma ti ke to pe la VP ",
+		" 1  2  3  4  5  6  7    ",
+		" 8  9 10 11 12 13 14    ",
+		"15 16 17 18 19 20 21    ",
+		"22 23 24 25 26 27 28 29 ",
+		"                        ",
+	}
+
+	lines := fmt.MonthToLinesWithOptions(2021, cal.December, nil, &fmt.Options{Locale: cal.FinnishLocale()})
+	for i := range expected {
+		if lines[i] != expected[i] {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, expected[i], lines[i])
+		}
+	}
+}
+
+func TestRelationViewInFinnish(t *testing.T) {
+	opts := &fmt.Options{Locale: cal.FinnishLocale()}
+	lines := fmt.MonthToLinesWithCalendar(2022, cal.Sol, nil, fmt.GregorianLabeler(opts.Locale), opts)
+	expected := strings.Repeat(" ", 3*13) + "heinäkuu"
+	if strings.TrimRight(lines[5], " ") != expected {
+		t.Errorf("Expected month line '%s' but found '%s'\n", expected, lines[5])
+	}
+
+	lines = fmt.MonthToLinesWithCalendar(2022, cal.January, nil, fmt.JulianLabeler(opts.Locale), opts)
+	expected = strings.Repeat(" ", 3*13) + "tammikuu"
+	if strings.TrimRight(lines[5], " ") != expected {
+		t.Errorf("Expected month line '%s' but found '%s'\n", expected, lines[5])
+	}
+}
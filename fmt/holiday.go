@@ -0,0 +1,67 @@
+package fmt
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+type monthDay struct {
+	month cal.IFCMonth
+	day   int
+}
+
+// HolidayMarker marks the holidays of a provider with the given symbol.
+// The holidays of each year are looked up only once.
+func HolidayMarker(provider cal.HolidayProvider, symbol rune) DayMarker {
+	return holidayMarker(provider, func(holiday cal.Holiday) rune {
+		return symbol
+	})
+}
+
+// holidayMarker marks the holidays of a provider with the symbol of the
+// first holiday of each day. The marker may be shared between goroutines.
+func holidayMarker(provider cal.HolidayProvider, symbolOf func(holiday cal.Holiday) rune) DayMarker {
+	var mu sync.Mutex
+	symbolsByYear := make(map[int]map[monthDay]rune)
+	symbolsIn := func(year int) map[monthDay]rune {
+		mu.Lock()
+		defer mu.Unlock()
+		symbols, ok := symbolsByYear[year]
+		if !ok {
+			symbols = make(map[monthDay]rune)
+			for _, holiday := range provider.Holidays(year) {
+				day := monthDay{holiday.Date.Month, holiday.Date.Day}
+				if _, ok := symbols[day]; !ok {
+					symbols[day] = symbolOf(holiday)
+				}
+			}
+			symbolsByYear[year] = symbols
+		}
+		return symbols
+	}
+
+	return func(date *cal.IFCDate) rune {
+		return symbolsIn(date.Year)[monthDay{date.Month, date.Day}]
+	}
+}
+
+// HolidaysToLines lists the holidays of an IFC month, one per line.
+func HolidaysToLines(provider cal.HolidayProvider, year int, month cal.IFCMonth, opts *Options) []string {
+	var lines []string
+	for _, holiday := range provider.Holidays(year) {
+		if holiday.Date.Month != month {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%2d %s  %s", holiday.Date.Day, opts.locale().MonthName(month), opts.locale().Message(holiday.Name)))
+	}
+	return lines
+}
+
+// SeasonMarker marks the days of equinoxes and solstices in a time zone
+// with a tilde.
+func SeasonMarker(loc *time.Location) DayMarker {
+	return HolidayMarker(cal.SeasonalEventsIn(loc), '~')
+}
@@ -0,0 +1,84 @@
+package fmt_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+	"github.com/Lateks/cotsworth/fmt"
+)
+
+func TestMonthFormattingWithHolidays(t *testing.T) {
+	opts := &fmt.Options{Markers: []fmt.DayMarker{fmt.HolidayMarker(cal.IFCObservances, '*')}}
+	expected := []string{
+		"     December 2021      ",
+		"Su Mo Tu We Th Fr Sa YD ",
+		" 1  2  3  4  5  6  7    ",
+		" 8  9 10 11 12 13 14    ",
+		"15 16 17 18 19 20 21    ",
+		"22 23 24 25 26 27 28 29*",
+		"                        ",
+	}
+
+	lines := fmt.MonthToLinesWithOptions(2021, cal.December, nil, opts)
+	if len(lines) != len(expected) {
+		t.Fatalf("Expected %d lines but found %d\n", len(expected), len(lines))
+	}
+	for i := range lines {
+		if lines[i] != expected[i] {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, expected[i], lines[i])
+		}
+	}
+}
+
+func TestHolidaysToLines(t *testing.T) {
+	expected := []string{
+		" 2 Sol  Juneteenth",
+		"17 Sol  Independence Day",
+	}
+
+	lines := fmt.HolidaysToLines(cal.USFederalHolidays, 2023, cal.Sol, nil)
+	if len(lines) != len(expected) {
+		t.Fatalf("Expected %+v but found %+v\n", expected, lines)
+	}
+	for i := range lines {
+		if lines[i] != expected[i] {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, expected[i], lines[i])
+		}
+	}
+}
+
+func TestHolidaysToLinesInFinnish(t *testing.T) {
+	lines := fmt.HolidaysToLines(cal.FinnishHolidays, 2022, cal.December, &fmt.Options{Locale: cal.FinnishLocale()})
+	expected := "23 joulukuu  joulupäivä"
+	if len(lines) != 4 || lines[2] != expected {
+		t.Errorf("Expected the third line to be '%s' but found %+v\n", expected, lines)
+	}
+}
+
+func TestRelationViewWithSeasons(t *testing.T) {
+	opts := &fmt.Options{Markers: []fmt.DayMarker{fmt.SeasonMarker(time.UTC)}}
+
+	lines := fmt.MonthToLinesWithCalendar(2024, cal.March, nil, fmt.GregorianLabel, opts)
+	expected := "22 23 24~25 26 27 28 "
+	if !strings.HasSuffix(lines[2], expected) {
+		t.Errorf("Expected day numbers to end with '%s' but found '%s'\n", expected, lines[2])
+	}
+}
+
+func TestHolidayMarkerIsSafeForConcurrentUse(t *testing.T) {
+	marker := fmt.HolidayMarker(cal.IFCObservances, '*')
+	var wg sync.WaitGroup
+	for year := 2020; year < 2030; year++ {
+		wg.Add(1)
+		go func(year int) {
+			defer wg.Done()
+			if marker(cal.NewIFCDate(year, cal.December, 29)) != '*' {
+				t.Errorf("Expected Year Day %d to be marked\n", year)
+			}
+		}(year)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,145 @@
+package fmt
+
+import (
+	"html"
+	"io"
+	"strconv"
+)
+
+// HTMLStyle is a default style sheet for the classes of HTMLRenderer.
+const HTMLStyle = `table.ifc-month { border-collapse: collapse; margin: 0 1em 1em 0; display: inline-table; }
+.ifc-month caption { font-weight: bold; padding: 0.25em; }
+.ifc-month th, .ifc-month td { width: 2.5em; padding: 0.25em; text-align: right; vertical-align: top; }
+.ifc-month th { font-weight: normal; color: #666; }
+.ifc-month .ifc-intercalary { background: #f3efe0; }
+.ifc-month .ifc-today { background: #222; color: #fff; }
+.ifc-month .ifc-related { display: block; font-size: 0.7em; color: #888; }
+.ifc-month .ifc-today .ifc-related { color: #ccc; }
+.ifc-month .ifc-marker { font-size: 0.8em; color: #b00; }
+.ifc-month .ifc-annotation { display: block; font-size: 0.6em; text-align: left; color: #b00; }
+`
+
+// HTMLRenderer writes months as tables. Each month is a table with the
+// title as its caption and the weekdays in its head. Leap Day and Year Day
+// get a column of their own, and days labeled with another calendar show
+// the related day in a subscript. The classes are:
+//
+//	ifc-month        the table of a month
+//	ifc-day          a day of a week
+//	ifc-intercalary  the column of Leap Day or Year Day
+//	ifc-leap-day     Leap Day
+//	ifc-year-day     Year Day
+//	ifc-empty        the empty cells of the intercalary column
+//	ifc-today        the highlighted day
+//	ifc-marked       a day with a marker, which is in an ifc-marker span
+//	ifc-related      the subscript of the related day
+//	ifc-annotation   the name of an annotated event, with the class of its
+//	                 kind: ifc-holiday, ifc-season or ifc-moon-phase
+type HTMLRenderer struct {
+	// Document wraps the tables in an HTML document using HTMLStyle.
+	Document bool
+}
+
+const (
+	htmlDocumentStart = "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<style>\n" + HTMLStyle + "</style>\n</head>\n<body>\n"
+	htmlDocumentEnd   = "</body>\n</html>\n"
+)
+
+func (r HTMLRenderer) Render(w io.Writer, months []*MonthLayout) error {
+	if r.Document {
+		if _, err := io.WriteString(w, htmlDocumentStart); err != nil {
+			return err
+		}
+	}
+
+	buf := make([]byte, 0, 4*monthBufferSize)
+	for _, month := range months {
+		buf = appendHTMLMonth(buf[:0], month)
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+
+	if r.Document {
+		_, err := io.WriteString(w, htmlDocumentEnd)
+		return err
+	}
+	return nil
+}
+
+func appendHTMLMonth(buf []byte, month *MonthLayout) []byte {
+	buf = append(buf, "<table class=\"ifc-month\">\n<caption>"...)
+	buf = append(buf, html.EscapeString(month.Title)...)
+	buf = append(buf, "</caption>\n<thead>\n<tr>"...)
+	for _, name := range month.Weekdays {
+		buf = append(buf, "<th scope=\"col\">"...)
+		buf = append(buf, html.EscapeString(name)...)
+		buf = append(buf, "</th>"...)
+	}
+	if month.IntercalaryDay != "" {
+		buf = append(buf, "<th scope=\"col\" class=\"ifc-intercalary\">"...)
+		buf = append(buf, html.EscapeString(month.IntercalaryDay)...)
+		buf = append(buf, "</th>"...)
+	}
+	buf = append(buf, "</tr>\n</thead>\n<tbody>\n"...)
+
+	for w := range month.Weeks {
+		week := &month.Weeks[w]
+		buf = append(buf, "<tr>"...)
+		for d := range week.Days {
+			buf = appendHTMLDay(buf, &week.Days[d])
+		}
+		if week.Intercalary != nil {
+			buf = appendHTMLDay(buf, week.Intercalary)
+		} else if month.IntercalaryDay != "" {
+			buf = append(buf, "<td class=\"ifc-intercalary ifc-empty\"></td>"...)
+		}
+		buf = append(buf, "</tr>\n"...)
+	}
+	return append(buf, "</tbody>\n</table>\n"...)
+}
+
+func appendHTMLDay(buf []byte, day *DayCell) []byte {
+	buf = append(buf, "<td class=\"ifc-day"...)
+	switch {
+	case day.Date.IsLeapDay():
+		buf = append(buf, " ifc-intercalary ifc-leap-day"...)
+	case day.Date.IsYearDay():
+		buf = append(buf, " ifc-intercalary ifc-year-day"...)
+	}
+	if day.Marker != 0 {
+		buf = append(buf, " ifc-marked"...)
+	}
+	if day.Highlighted {
+		buf = append(buf, " ifc-today\" aria-current=\"date"...)
+	}
+	buf = append(buf, "\"><time datetime=\""...)
+	buf = day.Date.ToUTCTime().AppendFormat(buf, "2006-01-02")
+	buf = append(buf, "\">"...)
+	buf = strconv.AppendInt(buf, int64(day.Date.Day), 10)
+	buf = append(buf, "</time>"...)
+	if day.Marker != 0 {
+		buf = append(buf, "<span class=\"ifc-marker\">"...)
+		buf = append(buf, html.EscapeString(string(day.Marker))...)
+		buf = append(buf, "</span>"...)
+	}
+	if day.Related != nil {
+		buf = append(buf, "<sub class=\"ifc-related\" title=\""...)
+		buf = strconv.AppendInt(buf, int64(day.Related.Day), 10)
+		buf = append(buf, ' ')
+		buf = append(buf, html.EscapeString(day.Related.Month)...)
+		buf = append(buf, ' ')
+		buf = strconv.AppendInt(buf, int64(day.Related.Year), 10)
+		buf = append(buf, "\">"...)
+		buf = strconv.AppendInt(buf, int64(day.Related.Day), 10)
+		buf = append(buf, "</sub>"...)
+	}
+	for _, annotation := range day.Annotations {
+		buf = append(buf, "<span class=\"ifc-annotation ifc-"...)
+		buf = append(buf, annotation.Kind.String()...)
+		buf = append(buf, "\">"...)
+		buf = append(buf, html.EscapeString(annotation.Name)...)
+		buf = append(buf, "</span>"...)
+	}
+	return append(buf, "</td>"...)
+}
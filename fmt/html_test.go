@@ -0,0 +1,84 @@
+package fmt_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+	"github.com/Lateks/cotsworth/fmt"
+)
+
+func renderHTML(t *testing.T, renderer fmt.HTMLRenderer, months []*fmt.MonthLayout) string {
+	var out bytes.Buffer
+	if err := renderer.Render(&out, months); err != nil {
+		t.Fatal(err)
+	}
+	return out.String()
+}
+
+func TestHTMLRenderer(t *testing.T) {
+	highlight := cal.NewIFCDate(2021, cal.December, 29)
+	marker := func(date *cal.IFCDate) rune {
+		if date.Day == 1 {
+			return '<'
+		}
+		return 0
+	}
+	opts := &fmt.Options{Markers: []fmt.DayMarker{marker}}
+	output := renderHTML(t, fmt.HTMLRenderer{}, fmt.LayoutMonths(cal.NewIFCDate(2021, cal.November, 1), 2, highlight, nil, opts))
+
+	for i, expected := range []string{
+		"<caption>November 2021</caption>",
+		"<caption>December 2021</caption>",
+		"<thead>\n<tr><th scope=\"col\">Su</th>",
+		"<th scope=\"col\" class=\"ifc-intercalary\">YD</th></tr>\n</thead>",
+		"<td class=\"ifc-intercalary ifc-empty\"></td></tr>",
+		"<td class=\"ifc-day ifc-intercalary ifc-year-day ifc-today\" aria-current=\"date\"><time datetime=\"2021-12-31\">29</time></td></tr>",
+		"<td class=\"ifc-day ifc-marked\"><time datetime=\"2021-12-03\">1</time><span class=\"ifc-marker\">&lt;</span></td>",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("%d: Expected '%s' in\n%s\n", i, expected, output)
+		}
+	}
+	if strings.Count(output, "<table") != 2 || strings.Count(output, "<tr>") != 10 {
+		t.Errorf("Expected two tables of five rows in\n%s\n", output)
+	}
+	if strings.Contains(output, "ifc-related") || strings.Contains(output, "<html>") {
+		t.Errorf("Unexpected subscripts or document in\n%s\n", output)
+	}
+	if november := output[:strings.Index(output, "December")]; strings.Contains(november, "ifc-intercalary") {
+		t.Errorf("Expected no intercalary column in November in\n%s\n", november)
+	}
+}
+
+func TestHTMLRendererWithGregorianSubscripts(t *testing.T) {
+	months := fmt.LayoutMonths(cal.NewIFCDate(2024, cal.June, 1), 1, nil, fmt.GregorianLabel, nil)
+	output := renderHTML(t, fmt.HTMLRenderer{Document: true}, months)
+
+	for i, expected := range []string{
+		"<!DOCTYPE html>",
+		fmt.HTMLStyle,
+		"<time datetime=\"2024-05-20\">1</time><sub class=\"ifc-related\" title=\"20 May 2024\">20</sub>",
+		"<td class=\"ifc-day ifc-intercalary ifc-leap-day\"><time datetime=\"2024-06-17\">29</time><sub class=\"ifc-related\" title=\"17 June 2024\">17</sub></td>",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("%d: Expected '%s' in\n%s\n", i, expected, output)
+		}
+	}
+	if !strings.HasSuffix(output, "</table>\n</body>\n</html>\n") {
+		t.Errorf("Expected the document to end after the table in\n%s\n", output)
+	}
+}
+
+func TestHTMLRendererWithAnnotations(t *testing.T) {
+	opts := &fmt.Options{Annotations: []fmt.AnnotatedDays{{Kind: fmt.SeasonAnnotation, Days: cal.SeasonalEventsIn(time.UTC)}}}
+	months := fmt.LayoutMonths(cal.NewIFCDate(2024, cal.March, 1), 1, nil, nil, opts)
+
+	out := renderHTML(t, fmt.HTMLRenderer{}, months)
+	expected := `<span class="ifc-annotation ifc-season">March equinox</span></td>`
+	if !strings.Contains(out, expected) {
+		t.Errorf("Expected %s in %s\n", expected, out)
+	}
+}
@@ -0,0 +1,73 @@
+package fmt
+
+import (
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+// A DayLabel names a day in a calendar that is shown in relation to the
+// IFC. The weekdays of all such calendars follow the continuous week.
+type DayLabel struct {
+	Year  int
+	Month string
+	Day   int
+}
+
+type DayLabeler func(t time.Time) DayLabel
+
+func GregorianLabel(t time.Time) DayLabel {
+	return DayLabel{Year: t.Year(), Month: t.Month().String(), Day: t.Day()}
+}
+
+// GregorianLabeler labels days with their Gregorian dates, naming the
+// months in the given locale.
+func GregorianLabeler(locale *cal.Locale) DayLabeler {
+	return func(t time.Time) DayLabel {
+		return DayLabel{Year: t.Year(), Month: locale.GregorianMonthName(t.Month()), Day: t.Day()}
+	}
+}
+
+func JulianLabel(t time.Time) DayLabel {
+	date := cal.JulianDateAt(t)
+	return DayLabel{Year: date.Year, Month: date.Month.String(), Day: date.Day}
+}
+
+// JulianLabeler labels days with their Julian dates, naming the months in
+// the given locale.
+func JulianLabeler(locale *cal.Locale) DayLabeler {
+	return func(t time.Time) DayLabel {
+		date := cal.JulianDateAt(t)
+		return DayLabel{Year: date.Year, Month: locale.GregorianMonthName(date.Month), Day: date.Day}
+	}
+}
+
+func IslamicLabel(t time.Time) DayLabel {
+	date := cal.IslamicDateAt(t)
+	return DayLabel{Year: date.Year, Month: date.Month.String(), Day: date.Day}
+}
+
+func HebrewLabel(t time.Time) DayLabel {
+	date := cal.HebrewDateAt(t)
+	return DayLabel{Year: date.Year, Month: date.MonthName(), Day: date.Day}
+}
+
+func CopticLabel(t time.Time) DayLabel {
+	date := cal.CopticDateAt(t)
+	return DayLabel{Year: date.Year, Month: date.Month.String(), Day: date.Day}
+}
+
+func EthiopianLabel(t time.Time) DayLabel {
+	date := cal.EthiopianDateAt(t)
+	return DayLabel{Year: date.Year, Month: date.Month.String(), Day: date.Day}
+}
+
+func PersianLabel(t time.Time) DayLabel {
+	date := cal.PersianDateAt(t)
+	return DayLabel{Year: date.Year, Month: date.Month.String(), Day: date.Day}
+}
+
+func FrenchRepublicanLabel(t time.Time) DayLabel {
+	date := cal.FrenchRepublicanDateAt(t)
+	return DayLabel{Year: date.Year, Month: date.Month.String(), Day: date.Day}
+}
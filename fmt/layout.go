@@ -0,0 +1,126 @@
+package fmt
+
+import (
+	"github.com/Lateks/cotsworth/cal"
+)
+
+// A DayCell is a day in the grid of a MonthLayout.
+type DayCell struct {
+	Date        *cal.IFCDate
+	Highlighted bool
+	// Marker is the symbol of the first DayMarker of the options that
+	// marks the day, or 0 if none does.
+	Marker rune
+	// Related names the day in the calendar of the labeler the month was
+	// laid out with, or is nil if there was none.
+	Related *DayLabel
+	// Annotations name the holidays and other events of the day from the
+	// Annotations of the options.
+	Annotations []Annotation
+}
+
+// An Annotation names a holiday or another event of a day.
+type Annotation struct {
+	Name string
+	Kind AnnotationKind
+}
+
+// Intercalary reports whether the day is Leap Day or Year Day, which are
+// not part of any week.
+func (c *DayCell) Intercalary() bool {
+	return c.Date.IsLeapDay() || c.Date.IsYearDay()
+}
+
+// A WeekLayout is a row of seven days from Sunday to Saturday. The last
+// week of December, and of June in leap years, is followed by an
+// intercalary day.
+type WeekLayout struct {
+	Days        []DayCell
+	Intercalary *DayCell
+}
+
+// A MonthLayout is an IFC month laid out in weeks, independent of how it
+// is rendered. The names are taken from the locale of the options.
+type MonthLayout struct {
+	Year  int
+	Month cal.IFCMonth
+	Title string
+	// Weekdays names the columns from Sunday to Saturday. IntercalaryDay
+	// names the column of the intercalary day, or is empty if the month
+	// has none.
+	Weekdays       []string
+	IntercalaryDay string
+	Weeks          []WeekLayout
+}
+
+// Days returns the cells of the month in date order.
+func (m *MonthLayout) Days() []*DayCell {
+	days := make([]*DayCell, 0, cal.DaysInMonth(m.Year, m.Month))
+	for w := range m.Weeks {
+		week := &m.Weeks[w]
+		for d := range week.Days {
+			days = append(days, &week.Days[d])
+		}
+		if week.Intercalary != nil {
+			days = append(days, week.Intercalary)
+		}
+	}
+	return days
+}
+
+// LayoutMonth lays out an IFC month. The day equal to highlightDay is
+// highlighted, and if labeler is not nil, each day is labeled with its
+// date in another calendar.
+func LayoutMonth(year int, month cal.IFCMonth, highlightDay *cal.IFCDate, labeler DayLabeler, opts *Options) *MonthLayout {
+	locale := opts.locale()
+	layout := &MonthLayout{
+		Year:     year,
+		Month:    month,
+		Title:    opts.monthTitle(year, month),
+		Weekdays: make([]string, cal.DaysInWeek),
+		Weeks:    make([]WeekLayout, cal.WeeksInMonth),
+	}
+	for wd := cal.Sunday; wd <= cal.Saturday; wd++ {
+		layout.Weekdays[wd] = locale.ShortWeekdayName(wd)
+	}
+
+	// The cells and dates of all weeks share one array each.
+	daysInMonth := cal.DaysInMonth(year, month)
+	cells := make([]DayCell, daysInMonth)
+	dates := make([]cal.IFCDate, daysInMonth)
+	annotations := opts.annotations(year, month)
+	var labels []DayLabel
+	if labeler != nil {
+		labels = make([]DayLabel, daysInMonth)
+	}
+	for i := range cells {
+		dates[i] = *cal.NewIFCDate(year, month, i+1)
+		date := &dates[i]
+		cells[i] = DayCell{
+			Date:        date,
+			Highlighted: highlightDay != nil && highlightDay.Equal(date),
+			Marker:      opts.marker(date),
+			Annotations: annotations[i+1],
+		}
+		if labeler != nil {
+			labels[i] = labeler(date.ToUTCTime())
+			cells[i].Related = &labels[i]
+		}
+	}
+
+	for w := range layout.Weeks {
+		layout.Weeks[w].Days = cells[w*cal.DaysInWeek : (w+1)*cal.DaysInWeek : (w+1)*cal.DaysInWeek]
+	}
+	if daysInMonth > cal.WeeksInMonth*cal.DaysInWeek {
+		intercalary := &cells[daysInMonth-1]
+		layout.Weeks[cal.WeeksInMonth-1].Intercalary = intercalary
+		layout.IntercalaryDay = locale.ShortWeekdayName(intercalary.Date.Weekday())
+	}
+	return layout
+}
+
+// gregorianWeekday returns the weekday of a day in the Gregorian week,
+// which the IFC weekdays are numbered like.
+func (c *DayCell) gregorianWeekday() cal.Weekday {
+	return cal.Weekday(c.Date.ToUTCTime().Weekday())
+}
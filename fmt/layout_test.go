@@ -0,0 +1,116 @@
+package fmt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+	"github.com/Lateks/cotsworth/fmt"
+)
+
+func TestLayoutMonth(t *testing.T) {
+	highlight := cal.NewIFCDate(2024, cal.June, 29)
+	marker := func(date *cal.IFCDate) rune {
+		if date.Day == 13 {
+			return '*'
+		}
+		return 0
+	}
+	layout := fmt.LayoutMonth(2024, cal.June, highlight, fmt.GregorianLabel, &fmt.Options{Markers: []fmt.DayMarker{marker}})
+
+	if layout.Title != "June 2024" || layout.IntercalaryDay != "LD" || len(layout.Weekdays) != cal.DaysInWeek {
+		t.Errorf("Unexpected month header %+v\n", layout)
+	}
+	if len(layout.Weeks) != cal.WeeksInMonth {
+		t.Fatalf("Expected %d weeks but found %d\n", cal.WeeksInMonth, len(layout.Weeks))
+	}
+	for w, week := range layout.Weeks {
+		if len(week.Days) != cal.DaysInWeek {
+			t.Errorf("%d: Expected %d days but found %d\n", w, cal.DaysInWeek, len(week.Days))
+		}
+		if w < cal.WeeksInMonth-1 && week.Intercalary != nil {
+			t.Errorf("%d: Unexpected intercalary day %+v\n", w, week.Intercalary)
+		}
+	}
+
+	leapDay := layout.Weeks[cal.WeeksInMonth-1].Intercalary
+	if leapDay == nil || !leapDay.Intercalary() || !leapDay.Highlighted {
+		t.Fatalf("Expected a highlighted Leap Day but found %+v\n", leapDay)
+	}
+	if leapDay.Related.Month != "June" || leapDay.Related.Day != 17 {
+		t.Errorf("Expected Leap Day on June 17 but found %+v\n", leapDay.Related)
+	}
+
+	days := layout.Days()
+	if len(days) != 29 {
+		t.Fatalf("Expected 29 days but found %d\n", len(days))
+	}
+	for i, day := range days {
+		if day.Date.Day != i+1 {
+			t.Errorf("%d: Expected day %d but found %d\n", i, i+1, day.Date.Day)
+		}
+		if day.Highlighted != (i == 28) || (day.Marker == '*') != (i == 12) {
+			t.Errorf("%d: Unexpected highlight or marker in %+v\n", i, day)
+		}
+	}
+}
+
+func TestLayoutMonthWithAnnotations(t *testing.T) {
+	opts := &fmt.Options{Annotations: []fmt.AnnotatedDays{
+		{Kind: fmt.HolidayAnnotation, Days: cal.IFCObservances},
+		{Kind: fmt.MoonPhaseAnnotation, Days: cal.MoonPhasesIn(time.UTC)},
+	}}
+	layout := fmt.LayoutMonth(2024, cal.June, nil, nil, opts)
+
+	days := layout.Days()
+	for _, input := range []struct {
+		day         int
+		annotations []fmt.Annotation
+	}{
+		{1, nil},
+		{18, []fmt.Annotation{{Name: "New moon", Kind: fmt.MoonPhaseAnnotation}}},
+		{29, []fmt.Annotation{{Name: "Leap Day", Kind: fmt.HolidayAnnotation}}},
+	} {
+		annotations := days[input.day-1].Annotations
+		if len(annotations) != len(input.annotations) || (len(annotations) > 0 && annotations[0] != input.annotations[0]) {
+			t.Errorf("%d: Expected annotations %+v but found %+v\n", input.day, input.annotations, annotations)
+		}
+	}
+}
+
+func TestLayoutMonthTranslatesAnnotations(t *testing.T) {
+	opts := &fmt.Options{
+		Locale:      cal.GermanLocale(),
+		Annotations: []fmt.AnnotatedDays{{Kind: fmt.MoonPhaseAnnotation, Days: cal.MoonPhasesIn(time.UTC)}},
+	}
+	layout := fmt.LayoutMonth(2024, cal.June, nil, nil, opts)
+
+	expected := []fmt.Annotation{{Name: "Neumond", Kind: fmt.MoonPhaseAnnotation}}
+	if annotations := layout.Days()[17].Annotations; len(annotations) != 1 || annotations[0] != expected[0] {
+		t.Errorf("Expected annotations %+v but found %+v\n", expected, annotations)
+	}
+}
+
+func TestLayoutMonthWithoutIntercalaryDay(t *testing.T) {
+	layout := fmt.LayoutMonth(2023, cal.June, nil, nil, nil)
+	if layout.IntercalaryDay != "" || layout.Weeks[cal.WeeksInMonth-1].Intercalary != nil {
+		t.Errorf("Expected no Leap Day in 2023 but found %+v\n", layout)
+	}
+	if related := layout.Weeks[0].Days[0].Related; related != nil {
+		t.Errorf("Expected no related dates but found %+v\n", related)
+	}
+}
+
+func TestLayoutMonths(t *testing.T) {
+	months := fmt.LayoutMonths(cal.NewIFCDate(2021, cal.December, 15), 3, nil, nil, nil)
+	expected := []*cal.IFCDate{
+		cal.NewIFCDate(2021, cal.December, 1),
+		cal.NewIFCDate(2022, cal.January, 1),
+		cal.NewIFCDate(2022, cal.February, 1),
+	}
+	for i, month := range months {
+		if month.Month != expected[i].Month || month.Year != expected[i].Year {
+			t.Errorf("%d: Expected %s %d but found %s %d\n", i, expected[i].Month, expected[i].Year, month.Month, month.Year)
+		}
+	}
+}
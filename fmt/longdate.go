@@ -0,0 +1,14 @@
+package fmt
+
+import (
+	"github.com/Lateks/cotsworth/cal"
+)
+
+// LongDate writes out a date as a phrase, such as "Friday, the 13th of
+// Sol, 2022" or "Leap Day, 2024" in English. The phrase is taken from the
+// locale of the options. Days past the end of a month carry over into the
+// following months.
+func LongDate(date *cal.IFCDate, opts *Options) string {
+	date = cal.DateAt(cal.NewIFCDate(date.Year, date.Month, date.Day).ToUTCTime())
+	return opts.locale().LongDate(date, opts.era())
+}
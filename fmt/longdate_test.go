@@ -0,0 +1,57 @@
+package fmt_test
+
+import (
+	"testing"
+
+	"github.com/Lateks/cotsworth/cal"
+	"github.com/Lateks/cotsworth/fmt"
+)
+
+func TestLongDate(t *testing.T) {
+	for i, input := range []struct {
+		date   *cal.IFCDate
+		opts   *fmt.Options
+		result string
+	}{
+		{cal.NewIFCDate(2022, cal.Sol, 13), nil, "Friday, the 13th of Sol, 2022"},
+		{cal.NewIFCDate(2022, cal.January, 1), nil, "Sunday, the 1st of January, 2022"},
+		{cal.NewIFCDate(2022, cal.March, 22), nil, "Sunday, the 22nd of March, 2022"},
+		{cal.NewIFCDate(2021, cal.December, 29), nil, "Year Day, 2021"},
+		{cal.NewIFCDate(2024, cal.June, 29), nil, "Leap Day, 2024"},
+		{cal.NewIFCDate(2022, cal.Sol, 13), &fmt.Options{Era: cal.HoloceneEra}, "Friday, the 13th of Sol, 12022 HE"},
+		{cal.NewIFCDate(2022, cal.Sol, 13), &fmt.Options{Locale: cal.FinnishLocale()}, "perjantai 13. sol 2022"},
+		{cal.NewIFCDate(2022, cal.December, 29), &fmt.Options{Locale: cal.FinnishLocale()}, "vuodenpäivä 2022"},
+		{cal.NewIFCDate(2022, cal.Sol, 13), &fmt.Options{Locale: cal.GermanLocale()}, "Freitag, der 13. Sol 2022"},
+		{cal.NewIFCDate(2022, cal.Sol, 1), &fmt.Options{Locale: cal.FrenchLocale()}, "dimanche 1er sol 2022"},
+		{cal.NewIFCDate(2022, cal.Sol, 13), &fmt.Options{Locale: cal.FrenchLocale()}, "vendredi 13 sol 2022"},
+		{cal.NewIFCDate(2022, cal.Sol, 13), &fmt.Options{Locale: cal.SpanishLocale()}, "viernes, 13 de sol de 2022"},
+		{cal.NewIFCDate(2024, cal.June, 29), &fmt.Options{Locale: cal.SpanishLocale()}, "día bisiesto de 2024"},
+		{cal.NewIFCDate(2022, cal.Sol, 13), &fmt.Options{Locale: cal.JapaneseLocale()}, "2022年ソル月13日 金曜日"},
+		{cal.NewIFCDate(2024, cal.June, 29), &fmt.Options{Locale: cal.JapaneseLocale()}, "2024年 閏日"},
+		{cal.NewIFCDate(2022, cal.Sol, 13), &fmt.Options{Locale: cal.JapaneseLocale(), Era: cal.HoloceneEra}, "人類紀元12022年ソル月13日 金曜日"},
+		{cal.NewIFCDate(2022, cal.Sol, 13), &fmt.Options{Locale: cal.GermanLocale(), Era: cal.HoloceneEra}, "Freitag, der 13. Sol 12022 HE"},
+		{cal.NewIFCDate(2022, cal.Sol, 13), &fmt.Options{Locale: chinese}, "星期五 13 太阳月 2022"},
+	} {
+		formatted := fmt.LongDate(input.date, input.opts)
+		if formatted != input.result {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, input.result, formatted)
+		}
+	}
+}
+
+func TestLongDateCarriesOverInvalidDays(t *testing.T) {
+	for i, input := range []struct {
+		date   *cal.IFCDate
+		result string
+	}{
+		{&cal.IFCDate{Year: 2022, Month: cal.June, Day: 29}, "Sunday, the 1st of Sol, 2022"},
+		{&cal.IFCDate{Year: 2021, Month: cal.December, Day: 30}, "Sunday, the 1st of January, 2022"},
+		{&cal.IFCDate{Year: 2022, Month: cal.IFCMonth(14), Day: 2}, "Sunday, the 1st of January, 2023"},
+		{&cal.IFCDate{Year: 2022, Month: cal.January, Day: 0}, "Year Day, 2021"},
+	} {
+		formatted := fmt.LongDate(input.date, nil)
+		if formatted != input.result {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, input.result, formatted)
+		}
+	}
+}
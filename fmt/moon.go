@@ -0,0 +1,26 @@
+package fmt
+
+import (
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+// MoonPhaseSymbols are the markers of the principal phases of the Moon as
+// seen from the northern hemisphere.
+var MoonPhaseSymbols = []rune{'●', '◐', '○', '◑'}
+
+// MoonPhaseMarker marks the days of the principal phases of the Moon in a
+// time zone with MoonPhaseSymbols.
+func MoonPhaseMarker(loc *time.Location) DayMarker {
+	return holidayMarker(cal.MoonPhasesIn(loc), moonPhaseSymbol)
+}
+
+func moonPhaseSymbol(holiday cal.Holiday) rune {
+	for phase := cal.NewMoon; phase <= cal.LastQuarter; phase++ {
+		if holiday.Name == phase.String() {
+			return MoonPhaseSymbols[phase]
+		}
+	}
+	return 0
+}
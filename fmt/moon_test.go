@@ -0,0 +1,29 @@
+package fmt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+	"github.com/Lateks/cotsworth/fmt"
+)
+
+func TestMonthFormattingWithMoonPhases(t *testing.T) {
+	opts := &fmt.Options{Markers: []fmt.DayMarker{fmt.MoonPhaseMarker(time.UTC)}}
+	expected := []string{
+		"      January 2024      ",
+		"Su Mo Tu We Th Fr Sa    ",
+		" 1  2  3  4◑ 5  6  7    ",
+		" 8  9 10 11●12 13 14    ",
+		"15 16 17 18◐19 20 21    ",
+		"22 23 24 25○26 27 28    ",
+		"                        ",
+	}
+
+	lines := fmt.MonthToLinesWithOptions(2024, cal.January, nil, opts)
+	for i := range expected {
+		if lines[i] != expected[i] {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, expected[i], lines[i])
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package fmt
+
+import (
+	"fmt"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+// Options control the optional features of the IFC month renderers. A nil
+// *Options renders with the defaults.
+type Options struct {
+	Era     cal.Era
+	Markers []DayMarker
+	// Locale names the months and weekdays. The default is English.
+	Locale *cal.Locale
+	// Annotations name the days of holiday providers in the cells of month
+	// layouts, for the renderers that have room for them.
+	Annotations []AnnotatedDays
+}
+
+// An AnnotationKind tells what kind of day an Annotation names.
+type AnnotationKind int
+
+const (
+	HolidayAnnotation AnnotationKind = iota
+	SeasonAnnotation
+	MoonPhaseAnnotation
+)
+
+var annotationKindNames = []string{"holiday", "season", "moon-phase"}
+
+func (k AnnotationKind) String() string {
+	if k >= HolidayAnnotation && k <= MoonPhaseAnnotation {
+		return annotationKindNames[k]
+	}
+	return fmt.Sprintf("%%!AnnotationKind(%d)", int(k))
+}
+
+// AnnotatedDays are the days of a holiday provider that are annotated with
+// the given kind.
+type AnnotatedDays struct {
+	Kind AnnotationKind
+	Days cal.HolidayProvider
+}
+
+// A DayMarker returns a one-column symbol that is printed after the day
+// number in the month grids, or 0 if the day is not marked. The first
+// marker that marks a day wins.
+type DayMarker func(date *cal.IFCDate) rune
+
+func (o *Options) era() cal.Era {
+	if o == nil {
+		return cal.CommonEra
+	}
+	return o.Era
+}
+
+func (o *Options) locale() *cal.Locale {
+	if o == nil || o.Locale == nil {
+		return cal.EnglishLocale()
+	}
+	return o.Locale
+}
+
+func (o *Options) monthTitle(year int, month cal.IFCMonth) string {
+	return fmt.Sprintf("%s %s", o.locale().MonthName(month), o.era().FormatYear(year))
+}
+
+func (o *Options) marker(date *cal.IFCDate) rune {
+	if o == nil {
+		return 0
+	}
+	for _, marker := range o.Markers {
+		if symbol := marker(date); symbol != 0 {
+			return symbol
+		}
+	}
+	return 0
+}
+
+// annotations returns the annotations of the days of a month by day
+// number.
+func (o *Options) annotations(year int, month cal.IFCMonth) map[int][]Annotation {
+	if o == nil || len(o.Annotations) == 0 {
+		return nil
+	}
+	annotations := make(map[int][]Annotation)
+	for _, annotated := range o.Annotations {
+		for _, holiday := range annotated.Days.Holidays(year) {
+			if holiday.Date.Month == month {
+				annotations[holiday.Date.Day] = append(annotations[holiday.Date.Day], Annotation{o.locale().Message(holiday.Name), annotated.Kind})
+			}
+		}
+	}
+	return annotations
+}
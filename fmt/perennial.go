@@ -0,0 +1,77 @@
+package fmt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+// perennialWeeksInMonth returns the number of week lines needed by the
+// longest month of the calendar, so that all months have the same height.
+func perennialWeeksInMonth(calendar *cal.PerennialCalendar) int {
+	weeks := 0
+	for month := 1; month <= calendar.MonthsInYear(); month++ {
+		offset := calendar.NewDate(0, month, 1).Weekday()
+		daysInLayout := offset + calendar.DaysInMonth(month)
+		monthWeeks := (daysInLayout + calendar.DaysInWeek() - 1) / calendar.DaysInWeek()
+		if monthWeeks > weeks {
+			weeks = monthWeeks
+		}
+	}
+	return weeks
+}
+
+// PerennialMonthToLines lays out a month of a calendar loaded from a spec
+// like MonthToLines lays out IFC months: the intercalary days following
+// the month are shown in extra columns after the last week.
+func PerennialMonthToLines(calendar *cal.PerennialCalendar, year int, month int, currentDate *cal.PerennialDate) []string {
+	daysInWeek := calendar.DaysInWeek()
+	extraColumns := calendar.MaxIntercalaryDays()
+	width := (daysInWeek + extraColumns) * cellWidth
+	intercalaryDays := calendar.IntercalaryDays(year, month)
+
+	header := ""
+	for weekday := 0; weekday < daysInWeek; weekday++ {
+		header += padRight(calendar.ShortWeekdayName(weekday), cellWidth-1) + " "
+	}
+	for _, day := range intercalaryDays {
+		header += padRight(day.Short, cellWidth-1) + " "
+	}
+
+	lines := []string{
+		CenterInField(fmt.Sprintf("%s %d", calendar.MonthName(month), year), width),
+		padRight(header, width),
+	}
+
+	formatCell := func(day int) string {
+		date := calendar.NewDate(year, month, day)
+		if currentDate != nil && currentDate.Equal(date) {
+			return fmt.Sprintf("\033[7m%2d\033[0m ", day)
+		}
+		return fmt.Sprintf("%2d ", day)
+	}
+
+	daysInMonth := calendar.DaysInMonth(month)
+	column := calendar.NewDate(year, month, 1).Weekday()
+	line := strings.Repeat(" ", column*cellWidth)
+	for day := 1; day <= daysInMonth; day++ {
+		line += formatCell(day)
+		column++
+		if column == daysInWeek && day < daysInMonth {
+			lines = append(lines, padRight(line, width))
+			line = ""
+			column = 0
+		}
+	}
+	line += strings.Repeat(" ", (daysInWeek-column)*cellWidth)
+	for i := range intercalaryDays {
+		line += formatCell(daysInMonth + i + 1)
+	}
+	lines = append(lines, padRight(line, width))
+
+	for len(lines) < perennialWeeksInMonth(calendar)+3 {
+		lines = append(lines, strings.Repeat(" ", width))
+	}
+	return lines
+}
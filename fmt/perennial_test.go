@@ -0,0 +1,69 @@
+package fmt_test
+
+import (
+	"testing"
+
+	"github.com/Lateks/cotsworth/cal"
+	"github.com/Lateks/cotsworth/fmt"
+)
+
+func TestPerennialIFCMatchesMonthFormatting(t *testing.T) {
+	ifc, err := cal.NewPerennialCalendar(cal.IFCSpec)
+	if err != nil {
+		t.Fatalf("Error creating IFC calendar: %s", err)
+	}
+
+	for i, input := range []struct {
+		year      int
+		month     cal.IFCMonth
+		highlight *cal.IFCDate
+	}{
+		{2021, cal.January, nil},
+		{2020, cal.June, cal.NewIFCDate(2020, cal.June, 29)},
+		{2021, cal.June, nil},
+		{2021, cal.December, cal.NewIFCDate(2021, cal.December, 19)},
+	} {
+		var highlight *cal.PerennialDate
+		if input.highlight != nil {
+			highlight = ifc.DateAt(input.highlight.ToUTCTime())
+		}
+
+		expected := fmt.MonthToLines(input.year, input.month, input.highlight)
+		lines := fmt.PerennialMonthToLines(ifc, input.year, int(input.month), highlight)
+		if len(expected) != len(lines) {
+			t.Fatalf("%d: Expected %d lines in result but found %d (was: %+v)\n", i, len(expected), len(lines), lines)
+		}
+		for j := range expected {
+			if expected[j] != lines[j] {
+				t.Errorf("%d: Expected '%s' but found '%s'\n", i, expected[j], lines[j])
+			}
+		}
+	}
+}
+
+func TestWorldCalendarMonthFormatting(t *testing.T) {
+	world, err := cal.NewPerennialCalendar(cal.WorldCalendarSpec)
+	if err != nil {
+		t.Fatalf("Error creating World Calendar: %s", err)
+	}
+
+	lines := fmt.PerennialMonthToLines(world, 2024, 6, nil)
+	expected := []string{
+		"       June 2024        ",
+		"Su Mo Tu We Th Fr Sa LD ",
+		"                1  2    ",
+		" 3  4  5  6  7  8  9    ",
+		"10 11 12 13 14 15 16    ",
+		"17 18 19 20 21 22 23    ",
+		"24 25 26 27 28 29 30 31 ",
+		"                        ",
+	}
+	if len(expected) != len(lines) {
+		t.Fatalf("Expected %d lines in result but found %d (was: %+v)\n", len(expected), len(lines), lines)
+	}
+	for j := range expected {
+		if expected[j] != lines[j] {
+			t.Errorf("Expected '%s' but found '%s'\n", expected[j], lines[j])
+		}
+	}
+}
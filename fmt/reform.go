@@ -0,0 +1,84 @@
+package fmt
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+// ReformTransitionToLines shows the last Gregorian month of a reform
+// calendar next to its first IFC month. Only the days that are in use are
+// shown: the Gregorian month ends on the day before the reform, and the IFC
+// month starts on the day the reform takes effect.
+func ReformTransitionToLines(reform *cal.ReformCalendar, highlightDate time.Time, opts *Options) []string {
+	lastGregorian := reform.LastGregorianDate()
+	gregorianLines := weekGridToLines(
+		fmt.Sprintf("%s %s", opts.locale().GregorianMonthName(lastGregorian.Month()), opts.era().FormatYear(lastGregorian.Year())),
+		lastGregorian.AddDate(0, 0, 1-lastGregorian.Day()),
+		lastGregorian.Day(),
+		highlightDate,
+		opts,
+	)
+
+	firstIFC := cal.DateAt(reform.EffectiveDate())
+	var ifcHighlight *cal.IFCDate
+	if !highlightDate.IsZero() && reform.IsReformed(highlightDate) {
+		ifcHighlight = cal.DateAt(highlightDate)
+	}
+	ifcLines := monthFromDayToLines(LayoutMonth(firstIFC.Year, firstIFC.Month, ifcHighlight, nil, opts), firstIFC.Day)
+	for len(ifcLines) < len(gregorianLines) {
+		ifcLines = append(ifcLines, strings.Repeat(" ", monthWidth))
+	}
+
+	lines := []string{CenterInField(opts.locale().Message("Gregorian"), weekGridWidth) + "   " +
+		CenterInField(opts.locale().Message("IFC"), monthWidth)}
+	for i := range gregorianLines {
+		lines = append(lines, gregorianLines[i]+"   "+ifcLines[i])
+	}
+	return lines
+}
+
+// ReformDate writes out a day of a reform calendar as a phrase, tagged with
+// the calendar in use on that day.
+func ReformDate(date *cal.ReformDate, opts *Options) string {
+	if date.IsIFC() {
+		return fmt.Sprintf(opts.locale().Message("%s (IFC)"), LongDate(date.IFC, opts))
+	}
+	return fmt.Sprintf(opts.locale().Message("%s (Gregorian)"), opts.locale().GregorianDate(date.Gregorian))
+}
+
+// monthFromDayToLines renders a month grid as text with the days that
+// precede the given day left blank.
+func monthFromDayToLines(month *MonthLayout, firstDay int) []string {
+	lines := make([]string, monthLines)
+	buf := make([]byte, 0, monthBufferSize/monthLines)
+	for i := range lines {
+		week := i - 2
+		if week < 0 || week >= len(month.Weeks) {
+			buf = appendMonthLine(buf[:0], month, i)
+		} else {
+			buf = appendWeekFromDay(buf[:0], &month.Weeks[week], firstDay)
+		}
+		lines[i] = string(buf)
+	}
+	return lines
+}
+
+func appendWeekFromDay(buf []byte, week *WeekLayout, firstDay int) []byte {
+	for d := range week.Days {
+		buf = appendDayFrom(buf, &week.Days[d], firstDay)
+	}
+	if week.Intercalary != nil {
+		return appendDayFrom(buf, week.Intercalary, firstDay)
+	}
+	return appendSpaces(buf, cellWidth)
+}
+
+func appendDayFrom(buf []byte, day *DayCell, firstDay int) []byte {
+	if day.Date.Day < firstDay {
+		return appendSpaces(buf, cellWidth)
+	}
+	return appendDay(buf, day)
+}
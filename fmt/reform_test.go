@@ -0,0 +1,100 @@
+package fmt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Lateks/cotsworth/cal"
+	"github.com/Lateks/cotsworth/fmt"
+)
+
+func TestReformTransitionFormatting(t *testing.T) {
+	reform := &cal.ReformCalendar{
+		Adoption: time.Date(2023, time.March, 15, 0, 0, 0, 0, time.UTC),
+		Rule:     cal.AdoptImmediately,
+	}
+
+	lines := fmt.ReformTransitionToLines(reform, time.Date(2023, time.March, 20, 0, 0, 0, 0, time.UTC), nil)
+	expected := []string{
+		"      Gregorian                   IFC           ",
+		"     March 2023                March 2023       ",
+		"Su Mo Tu We Th Fr Sa    Su Mo Tu We Th Fr Sa    ",
+		"          1  2  3  4                            ",
+		" 5  6  7  8  9 10 11                            ",
+		"12 13 14                         18 19 20 21    ",
+		"                        22 \033[7m23\033[0m 24 25 26 27 28    ",
+		"                                                ",
+		"                                                ",
+	}
+	if len(expected) != len(lines) {
+		t.Fatalf("Expected %d lines in result but found %d (was: %+v)\n", len(expected), len(lines), lines)
+	}
+	for j := range expected {
+		if expected[j] != lines[j] {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", j, expected[j], lines[j])
+		}
+	}
+}
+
+func TestReformTransitionHidesMarkedDays(t *testing.T) {
+	reform := &cal.ReformCalendar{
+		Adoption: time.Date(2023, time.March, 15, 0, 0, 0, 0, time.UTC),
+		Rule:     cal.AdoptImmediately,
+	}
+	marker := func(date *cal.IFCDate) rune {
+		if date.Day%5 == 0 {
+			return '●'
+		}
+		return 0
+	}
+
+	lines := fmt.ReformTransitionToLines(reform, time.Time{}, &fmt.Options{Markers: []fmt.DayMarker{marker}})
+	expected := []string{
+		"12 13 14                         18 19 20●21    ",
+		"                        22 23 24 25●26 27 28    ",
+	}
+	for j := range expected {
+		if expected[j] != lines[j+5] {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", j, expected[j], lines[j+5])
+		}
+	}
+}
+
+func TestReformTransitionInJapanese(t *testing.T) {
+	reform := &cal.ReformCalendar{
+		Adoption: time.Date(2023, time.March, 15, 0, 0, 0, 0, time.UTC),
+		Rule:     cal.AdoptImmediately,
+	}
+
+	lines := fmt.ReformTransitionToLines(reform, time.Time{}, &fmt.Options{Locale: cal.JapaneseLocale()})
+	expected := []string{
+		"    グレゴリオ暦               国際固定暦       ",
+		"      3月 2023                  3月 2023        ",
+		"日 月 火 水 木 金 土    日 月 火 水 木 金 土    ",
+	}
+	for j := range expected {
+		if expected[j] != lines[j] {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", j, expected[j], lines[j])
+		}
+	}
+}
+
+func TestReformDate(t *testing.T) {
+	reform := &cal.ReformCalendar{
+		Adoption: time.Date(2023, time.March, 15, 0, 0, 0, 0, time.UTC),
+		Rule:     cal.AdoptImmediately,
+	}
+	opts := &fmt.Options{Locale: cal.FinnishLocale()}
+
+	for i, input := range []struct {
+		time   time.Time
+		result string
+	}{
+		{reform.EffectiveDate(), "keskiviikko 18. maaliskuu 2023 (IFC)"},
+		{reform.LastGregorianDate(), "tiistai 14. maaliskuu 2023 (gregoriaaninen kalenteri)"},
+	} {
+		if result := fmt.ReformDate(reform.DateAt(input.time), opts); result != input.result {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, input.result, result)
+		}
+	}
+}
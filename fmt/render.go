@@ -0,0 +1,180 @@
+package fmt
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/Lateks/cotsworth/cal"
+)
+
+// A Renderer writes laid out months to an output format.
+type Renderer interface {
+	Render(w io.Writer, months []*MonthLayout) error
+}
+
+// LayoutMonths lays out numMonths consecutive months starting from the
+// month of firstMonth.
+func LayoutMonths(firstMonth *cal.IFCDate, numMonths int, highlightDay *cal.IFCDate, labeler DayLabeler, opts *Options) []*MonthLayout {
+	months := make([]*MonthLayout, numMonths)
+	month := cal.NewIFCDate(firstMonth.Year, firstMonth.Month, 1)
+	for m := range months {
+		months[m] = LayoutMonth(month.Year, month.Month, highlightDay, labeler, opts)
+		month = month.PlusMonths(1)
+	}
+	return months
+}
+
+// TextRenderer writes month grids for terminals, like MonthToLines, with
+// the given number of months side by side. The highlighted day is shown
+// in reverse video.
+type TextRenderer struct {
+	MonthsPerRow int
+}
+
+func (r TextRenderer) monthsPerRow() int {
+	if r.MonthsPerRow < 1 {
+		return 1
+	}
+	return r.MonthsPerRow
+}
+
+func (r TextRenderer) Render(w io.Writer, months []*MonthLayout) error {
+	perRow := r.monthsPerRow()
+	if perRow > len(months) {
+		perRow = len(months)
+	}
+	buf := make([]byte, 0, perRow*monthBufferSize)
+	for len(months) > 0 {
+		row := months
+		if len(row) > perRow {
+			row = row[:perRow]
+		}
+		if err := r.writeRow(w, buf[:0], row); err != nil {
+			return err
+		}
+		months = months[len(row):]
+	}
+	return nil
+}
+
+// writeRow writes months side by side with a single write, using buf for
+// the output.
+func (r TextRenderer) writeRow(w io.Writer, buf []byte, row []*MonthLayout) error {
+	for line := 0; line < monthLines; line++ {
+		for _, month := range row {
+			buf = appendMonthLine(buf, month, line)
+		}
+		buf = append(buf, '\n')
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+// WriteMonths lays out and writes numMonths consecutive months starting
+// from the month of firstMonth. Only one row of months is laid out at a
+// time, and the output buffer is reused between rows, so that long ranges
+// such as centuries take little memory.
+func (r TextRenderer) WriteMonths(w io.Writer, firstMonth *cal.IFCDate, numMonths int, highlightDay *cal.IFCDate, opts *Options) error {
+	perRow := r.monthsPerRow()
+	buf := make([]byte, 0, perRow*monthBufferSize)
+	row := make([]*MonthLayout, 0, perRow)
+	month := cal.NewIFCDate(firstMonth.Year, firstMonth.Month, 1)
+	for m := 0; m < numMonths; m++ {
+		row = append(row, LayoutMonth(month.Year, month.Month, highlightDay, nil, opts))
+		month = month.PlusMonths(1)
+		if len(row) == perRow || m == numMonths-1 {
+			if err := r.writeRow(w, buf[:0], row); err != nil {
+				return err
+			}
+			row = row[:0]
+		}
+	}
+	return nil
+}
+
+type jsonLabel struct {
+	Year  int    `json:"year"`
+	Month string `json:"month"`
+	Day   int    `json:"day"`
+}
+
+type jsonAnnotation struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+}
+
+type jsonDay struct {
+	Day         int              `json:"day"`
+	Weekday     string           `json:"weekday"`
+	Highlighted bool             `json:"highlighted,omitempty"`
+	Marker      string           `json:"marker,omitempty"`
+	Related     *jsonLabel       `json:"related,omitempty"`
+	Annotations []jsonAnnotation `json:"annotations,omitempty"`
+}
+
+type jsonWeek struct {
+	Days        []jsonDay `json:"days"`
+	Intercalary *jsonDay  `json:"intercalary,omitempty"`
+}
+
+type jsonMonth struct {
+	Year           int        `json:"year"`
+	Month          int        `json:"month"`
+	Title          string     `json:"title"`
+	Weekdays       []string   `json:"weekdays"`
+	IntercalaryDay string     `json:"intercalaryDay,omitempty"`
+	Weeks          []jsonWeek `json:"weeks"`
+}
+
+func newJSONDay(day *DayCell) jsonDay {
+	d := jsonDay{
+		Day:         day.Date.Day,
+		Weekday:     day.Date.Weekday().String(),
+		Highlighted: day.Highlighted,
+	}
+	if day.Marker != 0 {
+		d.Marker = string(day.Marker)
+	}
+	if day.Related != nil {
+		d.Related = &jsonLabel{Year: day.Related.Year, Month: day.Related.Month, Day: day.Related.Day}
+	}
+	for _, annotation := range day.Annotations {
+		d.Annotations = append(d.Annotations, jsonAnnotation{Name: annotation.Name, Kind: annotation.Kind.String()})
+	}
+	return d
+}
+
+// JSONRenderer writes months as a JSON array. Weekdays are identified by
+// their English names, while titles and column names follow the locale.
+type JSONRenderer struct {
+	Indent string
+}
+
+func (r JSONRenderer) Render(w io.Writer, months []*MonthLayout) error {
+	out := make([]jsonMonth, len(months))
+	for m, month := range months {
+		out[m] = jsonMonth{
+			Year:           month.Year,
+			Month:          int(month.Month),
+			Title:          month.Title,
+			Weekdays:       month.Weekdays,
+			IntercalaryDay: month.IntercalaryDay,
+			Weeks:          make([]jsonWeek, len(month.Weeks)),
+		}
+		for i := range month.Weeks {
+			week := &month.Weeks[i]
+			out[m].Weeks[i].Days = make([]jsonDay, len(week.Days))
+			for d := range week.Days {
+				out[m].Weeks[i].Days[d] = newJSONDay(&week.Days[d])
+			}
+			if week.Intercalary != nil {
+				intercalary := newJSONDay(week.Intercalary)
+				out[m].Weeks[i].Intercalary = &intercalary
+			}
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", r.Indent)
+	return encoder.Encode(out)
+}
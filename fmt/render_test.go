@@ -0,0 +1,156 @@
+package fmt_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/Lateks/cotsworth/cal"
+	"github.com/Lateks/cotsworth/fmt"
+)
+
+func TestTextRendererMatchesMonthFormatting(t *testing.T) {
+	highlight := cal.NewIFCDate(2021, cal.December, 29)
+	months := fmt.LayoutMonths(cal.NewIFCDate(2021, cal.November, 1), 3, highlight, nil, nil)
+
+	var out bytes.Buffer
+	if err := (fmt.TextRenderer{MonthsPerRow: 2}).Render(&out, months); err != nil {
+		t.Fatal(err)
+	}
+
+	november := fmt.MonthToLines(2021, cal.November, highlight)
+	december := fmt.MonthToLines(2021, cal.December, highlight)
+	january := fmt.MonthToLines(2022, cal.January, highlight)
+	var expected []string
+	for i := range november {
+		expected = append(expected, november[i]+december[i])
+	}
+	expected = append(expected, january...)
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	if len(lines) != len(expected) {
+		t.Fatalf("Expected %d lines but found %d\n", len(expected), len(lines))
+	}
+	for i := range expected {
+		if lines[i] != expected[i] {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, expected[i], lines[i])
+		}
+	}
+}
+
+func TestJSONRenderer(t *testing.T) {
+	highlight := cal.NewIFCDate(2024, cal.June, 29)
+	months := fmt.LayoutMonths(cal.NewIFCDate(2024, cal.June, 1), 1, highlight, fmt.GregorianLabel, nil)
+
+	var out bytes.Buffer
+	if err := (fmt.JSONRenderer{}).Render(&out, months); err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded []struct {
+		Title          string
+		Weekdays       []string
+		IntercalaryDay string
+		Weeks          []struct {
+			Days []struct {
+				Day     int
+				Weekday string
+			}
+			Intercalary *struct {
+				Day         int
+				Weekday     string
+				Highlighted bool
+				Related     struct {
+					Month string
+					Day   int
+				}
+			}
+		}
+	}
+	if err := json.Unmarshal(out.Bytes(), &decoded); err != nil {
+		t.Fatalf("Error decoding %s: %s\n", out.String(), err)
+	}
+
+	if len(decoded) != 1 || decoded[0].Title != "June 2024" || decoded[0].IntercalaryDay != "LD" {
+		t.Fatalf("Unexpected output %s\n", out.String())
+	}
+	month := decoded[0]
+	if len(month.Weeks) != 4 || month.Weeks[3].Days[6].Day != 28 || month.Weeks[3].Days[6].Weekday != "Saturday" {
+		t.Errorf("Unexpected weeks in %s\n", out.String())
+	}
+	leapDay := month.Weeks[3].Intercalary
+	if leapDay == nil || leapDay.Weekday != "Leap Day" || !leapDay.Highlighted || leapDay.Related.Day != 17 {
+		t.Errorf("Unexpected Leap Day in %s\n", out.String())
+	}
+}
+
+func TestWriteMonthsMatchesRender(t *testing.T) {
+	first := cal.NewIFCDate(2020, cal.November, 1)
+	highlight := cal.NewIFCDate(2021, cal.June, 3)
+	renderer := fmt.TextRenderer{MonthsPerRow: 3}
+
+	var rendered, written bytes.Buffer
+	if err := renderer.Render(&rendered, fmt.LayoutMonths(first, 17, highlight, nil, nil)); err != nil {
+		t.Fatal(err)
+	}
+	if err := renderer.WriteMonths(&written, first, 17, highlight, nil); err != nil {
+		t.Fatal(err)
+	}
+	if written.String() != rendered.String() {
+		t.Errorf("Expected\n%s\nbut found\n%s\n", rendered.String(), written.String())
+	}
+}
+
+const benchmarkYears = 100
+
+func BenchmarkTextRendererCentury(b *testing.B) {
+	first := cal.NewIFCDate(2000, cal.January, 1)
+	highlight := cal.NewIFCDate(2024, cal.June, 29)
+	renderer := fmt.TextRenderer{MonthsPerRow: 3}
+
+	var out bytes.Buffer
+	renderer.WriteMonths(&out, first, benchmarkYears*cal.MonthsInYear, highlight, nil)
+	b.SetBytes(int64(out.Len()))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		renderer.WriteMonths(io.Discard, first, benchmarkYears*cal.MonthsInYear, highlight, nil)
+	}
+}
+
+func BenchmarkMonthToLinesCentury(b *testing.B) {
+	highlight := cal.NewIFCDate(2024, cal.June, 29)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for year := 2000; year < 2000+benchmarkYears; year++ {
+			for month := cal.January; month <= cal.December; month++ {
+				fmt.MonthToLines(year, month, highlight)
+			}
+		}
+	}
+}
+
+func BenchmarkJSONRendererDecade(b *testing.B) {
+	months := fmt.LayoutMonths(cal.NewIFCDate(2020, cal.January, 1), 10*cal.MonthsInYear, nil, fmt.GregorianLabel, nil)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		(fmt.JSONRenderer{}).Render(io.Discard, months)
+	}
+}
+
+func TestJSONRendererWithAnnotations(t *testing.T) {
+	opts := &fmt.Options{Annotations: []fmt.AnnotatedDays{{Kind: fmt.HolidayAnnotation, Days: cal.IFCObservances}}}
+	months := fmt.LayoutMonths(cal.NewIFCDate(2021, cal.December, 1), 1, nil, nil, opts)
+
+	var out bytes.Buffer
+	if err := (fmt.JSONRenderer{}).Render(&out, months); err != nil {
+		t.Fatal(err)
+	}
+	expected := `"annotations":[{"name":"Year Day","kind":"holiday"}]`
+	if !strings.Contains(out.String(), expected) || strings.Count(out.String(), `"annotations"`) != 1 {
+		t.Errorf("Expected one day with %s in %s\n", expected, out.String())
+	}
+}
@@ -0,0 +1,291 @@
+package fmt
+
+import (
+	"html"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// A PaperSize is the size of a sheet of paper in millimetres.
+type PaperSize struct {
+	Name          string
+	Width, Height float64
+}
+
+var (
+	a4      = PaperSize{"A4", 210, 297}
+	a3      = PaperSize{"A3", 297, 420}
+	letter  = PaperSize{"Letter", 215.9, 279.4}
+	tabloid = PaperSize{"Tabloid", 279.4, 431.8}
+)
+
+var paperSizes = []PaperSize{a4, a3, letter, tabloid}
+
+// A4 returns the size of an A4 sheet in portrait.
+func A4() PaperSize {
+	return a4
+}
+
+// A3 returns the size of an A3 sheet in portrait.
+func A3() PaperSize {
+	return a3
+}
+
+// Letter returns the size of a US Letter sheet in portrait.
+func Letter() PaperSize {
+	return letter
+}
+
+// Tabloid returns the size of a US Tabloid sheet in portrait.
+func Tabloid() PaperSize {
+	return tabloid
+}
+
+// LookupPaperSize finds a paper size by its name, ignoring case.
+func LookupPaperSize(name string) (PaperSize, bool) {
+	for _, paper := range paperSizes {
+		if strings.EqualFold(paper.Name, name) {
+			return paper, true
+		}
+	}
+	return PaperSize{}, false
+}
+
+// Landscape turns the paper sideways.
+func (p PaperSize) Landscape() PaperSize {
+	if p.Width < p.Height {
+		p.Width, p.Height = p.Height, p.Width
+	}
+	return p
+}
+
+// SVGStyle is the style sheet of the SVG calendars.
+const SVGStyle = `text { font-family: sans-serif; fill: #222; }
+.title { font-weight: bold; text-anchor: middle; }
+.weekday { fill: #666; text-anchor: middle; }
+.day { fill: none; stroke: #999; stroke-width: 0.2; }
+.intercalary { fill: #f3efe0; }
+.today { fill: #ddd; }
+.related { fill: #888; text-anchor: end; }
+.annotation { fill: #b00; }
+.notes { stroke: #bbb; stroke-width: 0.2; }
+`
+
+const (
+	svgColumns      = daysOnWeekLine
+	svgTitleShare   = 0.12
+	svgHeaderShare  = 0.07
+	svgNotesShare   = 0.22
+	svgNoteLines    = 4
+	svgMarginShare  = 0.05
+	svgRelatedShare = 0.45
+	// svgAnnotationShare is the size of the names of annotated events
+	// relative to the day numbers.
+	svgAnnotationShare = 0.35
+)
+
+// SVGRenderer lays out months on a sheet of paper as a wall calendar. The
+// months are arranged in the grid that suits the paper best, so a whole
+// year fits on one sheet, and a single month fills it. Each month has the
+// weeks of MonthToLines with a column for Leap Day and Year Day, and days
+// labeled with another calendar show the related date in small type, as
+// do the names of annotated events below the day numbers.
+type SVGRenderer struct {
+	// Paper is the size of the sheet. The default is A4 in portrait.
+	Paper PaperSize
+	// Notes leaves ruled space for notes below each month.
+	Notes bool
+}
+
+func (r SVGRenderer) paper() PaperSize {
+	if r.Paper.Width <= 0 || r.Paper.Height <= 0 {
+		return A4()
+	}
+	return r.Paper
+}
+
+// monthAspect is the preferred ratio of the width of a month to its
+// height.
+func (r SVGRenderer) monthAspect() float64 {
+	if r.Notes {
+		return 1
+	}
+	return 1.3
+}
+
+// grid returns the number of columns and rows of months that makes their
+// shape closest to the preferred one.
+func (r SVGRenderer) grid(numMonths int, width, height float64) (columns, rows int) {
+	best := math.Inf(1)
+	for c := 1; c <= numMonths; c++ {
+		rs := (numMonths + c - 1) / c
+		if d := math.Abs(math.Log(width / float64(c) / (height / float64(rs)) / r.monthAspect())); d < best {
+			best, columns, rows = d, c, rs
+		}
+	}
+	return
+}
+
+func (r SVGRenderer) Render(w io.Writer, months []*MonthLayout) error {
+	paper := r.paper()
+	buf := make([]byte, 0, 16*monthBufferSize)
+	buf = append(buf, `<svg xmlns="http://www.w3.org/2000/svg" width="`...)
+	buf = appendSVGNumber(buf, paper.Width)
+	buf = append(buf, `mm" height="`...)
+	buf = appendSVGNumber(buf, paper.Height)
+	buf = append(buf, `mm" viewBox="0 0 `...)
+	buf = appendSVGNumber(buf, paper.Width)
+	buf = append(buf, ' ')
+	buf = appendSVGNumber(buf, paper.Height)
+	buf = append(buf, "\">\n<style>\n"+SVGStyle+"</style>\n"...)
+	if _, err := w.Write(buf); err != nil {
+		return err
+	}
+
+	if len(months) > 0 {
+		margin := math.Min(paper.Width, paper.Height) * svgMarginShare
+		columns, rows := r.grid(len(months), paper.Width-2*margin, paper.Height-2*margin)
+		boxWidth := (paper.Width - 2*margin) / float64(columns)
+		boxHeight := (paper.Height - 2*margin) / float64(rows)
+		gap := margin / 2
+
+		for m, month := range months {
+			x := margin + float64(m%columns)*boxWidth + gap/2
+			y := margin + float64(m/columns)*boxHeight + gap/2
+			buf = r.appendMonth(buf[:0], month, x, y, boxWidth-gap, boxHeight-gap)
+			if _, err := w.Write(buf); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, "</svg>\n")
+	return err
+}
+
+func (r SVGRenderer) appendMonth(buf []byte, month *MonthLayout, x, y, width, height float64) []byte {
+	titleHeight := height * svgTitleShare
+	headerHeight := height * svgHeaderShare
+	notesHeight := 0.0
+	if r.Notes {
+		notesHeight = height * svgNotesShare
+	}
+	columnWidth := width / svgColumns
+	rowHeight := (height - titleHeight - headerHeight - notesHeight) / float64(len(month.Weeks))
+
+	buf = append(buf, "<g class=\"month\">\n"...)
+	buf = appendSVGText(buf, "title", x+width/2, y+titleHeight*0.75, titleHeight*0.6, month.Title)
+
+	headerY := y + titleHeight + headerHeight*0.75
+	for c, name := range month.Weekdays {
+		buf = appendSVGText(buf, "weekday", x+(float64(c)+0.5)*columnWidth, headerY, headerHeight*0.6, name)
+	}
+	if month.IntercalaryDay != "" {
+		buf = appendSVGText(buf, "weekday", x+(svgColumns-0.5)*columnWidth, headerY, headerHeight*0.6, month.IntercalaryDay)
+	}
+
+	gridY := y + titleHeight + headerHeight
+	fontSize := math.Min(rowHeight*0.3, columnWidth*0.35)
+	for w := range month.Weeks {
+		week := &month.Weeks[w]
+		cellY := gridY + float64(w)*rowHeight
+		for d := range week.Days {
+			buf = appendSVGDay(buf, &week.Days[d], x+float64(d)*columnWidth, cellY, columnWidth, rowHeight, fontSize)
+		}
+		if week.Intercalary != nil {
+			buf = appendSVGDay(buf, week.Intercalary, x+(svgColumns-1)*columnWidth, cellY, columnWidth, rowHeight, fontSize)
+		}
+	}
+
+	if r.Notes {
+		notesY := gridY + float64(len(month.Weeks))*rowHeight
+		for i := 1; i <= svgNoteLines; i++ {
+			lineY := notesY + notesHeight*float64(i)/(svgNoteLines+1)
+			buf = append(buf, "<line class=\"notes\" x1=\""...)
+			buf = appendSVGNumber(buf, x)
+			buf = append(buf, "\" y1=\""...)
+			buf = appendSVGNumber(buf, lineY)
+			buf = append(buf, "\" x2=\""...)
+			buf = appendSVGNumber(buf, x+width)
+			buf = append(buf, "\" y2=\""...)
+			buf = appendSVGNumber(buf, lineY)
+			buf = append(buf, "\"/>\n"...)
+		}
+	}
+	return append(buf, "</g>\n"...)
+}
+
+func appendSVGDay(buf []byte, day *DayCell, x, y, width, height, fontSize float64) []byte {
+	class := "day"
+	if day.Intercalary() {
+		class += " intercalary"
+	}
+	if day.Highlighted {
+		class += " today"
+	}
+	buf = append(buf, "<rect class=\""...)
+	buf = append(buf, class...)
+	buf = append(buf, "\" x=\""...)
+	buf = appendSVGNumber(buf, x)
+	buf = append(buf, "\" y=\""...)
+	buf = appendSVGNumber(buf, y)
+	buf = append(buf, "\" width=\""...)
+	buf = appendSVGNumber(buf, width)
+	buf = append(buf, "\" height=\""...)
+	buf = appendSVGNumber(buf, height)
+	buf = append(buf, "\"/>\n"...)
+
+	number := strconv.Itoa(day.Date.Day)
+	if day.Marker != 0 {
+		number += string(day.Marker)
+	}
+	textX := x + width*0.08
+	textY := y + height*0.08 + fontSize*0.8
+	buf = appendSVGText(buf, "number", textX, textY, fontSize, number)
+	for _, annotation := range day.Annotations {
+		textY += fontSize * svgAnnotationShare * 1.2
+		buf = appendSVGText(buf, "annotation "+annotation.Kind.String(), textX, textY, fontSize*svgAnnotationShare, annotation.Name)
+	}
+
+	if day.Related != nil {
+		related := strconv.Itoa(day.Related.Day)
+		if day.Related.Day == 1 || day.Date.Day == 1 {
+			related = day.Related.Month + " " + related
+		}
+		buf = appendSVGText(buf, "related", x+width*0.92, y+height*0.9, fontSize*svgRelatedShare, related)
+	}
+	return buf
+}
+
+func appendSVGText(buf []byte, class string, x, y, fontSize float64, text string) []byte {
+	buf = append(buf, "<text class=\""...)
+	buf = append(buf, class...)
+	buf = append(buf, "\" x=\""...)
+	buf = appendSVGNumber(buf, x)
+	buf = append(buf, "\" y=\""...)
+	buf = appendSVGNumber(buf, y)
+	buf = append(buf, "\" font-size=\""...)
+	buf = appendSVGNumber(buf, fontSize)
+	buf = append(buf, "\">"...)
+	buf = append(buf, html.EscapeString(text)...)
+	return append(buf, "</text>\n"...)
+}
+
+// appendSVGNumber writes a coordinate in millimetres with at most two
+// decimals.
+func appendSVGNumber(buf []byte, value float64) []byte {
+	start := len(buf)
+	buf = strconv.AppendFloat(buf, value, 'f', 2, 64)
+	for buf[len(buf)-1] == '0' {
+		buf = buf[:len(buf)-1]
+	}
+	if buf[len(buf)-1] == '.' {
+		buf = buf[:len(buf)-1]
+	}
+	if string(buf[start:]) == "-0" {
+		buf = append(buf[:start], '0')
+	}
+	return buf
+}
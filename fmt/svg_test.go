@@ -0,0 +1,117 @@
+package fmt_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/Lateks/cotsworth/cal"
+	"github.com/Lateks/cotsworth/fmt"
+)
+
+type svgDocument struct {
+	Width  string `xml:"width,attr"`
+	Height string `xml:"height,attr"`
+	Months []struct {
+		Texts []struct {
+			Class string `xml:"class,attr"`
+			Text  string `xml:",chardata"`
+		} `xml:"text"`
+		Rects []struct {
+			Class string `xml:"class,attr"`
+		} `xml:"rect"`
+		Lines []struct{} `xml:"line"`
+	} `xml:"g"`
+}
+
+func renderSVG(t *testing.T, renderer fmt.SVGRenderer, months []*fmt.MonthLayout) svgDocument {
+	var out bytes.Buffer
+	if err := renderer.Render(&out, months); err != nil {
+		t.Fatal(err)
+	}
+	var doc svgDocument
+	if err := xml.Unmarshal(out.Bytes(), &doc); err != nil {
+		t.Fatalf("Error parsing %s: %s\n", out.String(), err)
+	}
+	return doc
+}
+
+func TestSVGRendererYear(t *testing.T) {
+	highlight := cal.NewIFCDate(2024, cal.June, 29)
+	months := fmt.LayoutMonths(cal.NewIFCDate(2024, cal.January, 1), cal.MonthsInYear, highlight, fmt.GregorianLabel, nil)
+	doc := renderSVG(t, fmt.SVGRenderer{}, months)
+
+	if doc.Width != "210mm" || doc.Height != "297mm" {
+		t.Errorf("Expected A4 paper but found %s x %s\n", doc.Width, doc.Height)
+	}
+	if len(doc.Months) != cal.MonthsInYear {
+		t.Fatalf("Expected %d months but found %d\n", cal.MonthsInYear, len(doc.Months))
+	}
+
+	june := doc.Months[cal.June-1]
+	if june.Texts[0].Class != "title" || june.Texts[0].Text != "June 2024" {
+		t.Errorf("Expected the title June 2024 but found %+v\n", june.Texts[0])
+	}
+	if len(june.Rects) != 29 || june.Rects[28].Class != "day intercalary today" {
+		t.Errorf("Expected a highlighted Leap Day cell but found %+v\n", june.Rects)
+	}
+	related := 0
+	for _, text := range june.Texts {
+		if text.Class == "related" {
+			related++
+			if related == 1 && text.Text != "May 20" {
+				t.Errorf("Expected the first Gregorian date May 20 but found %s\n", text.Text)
+			}
+		}
+	}
+	if related != 29 {
+		t.Errorf("Expected 29 Gregorian dates but found %d\n", related)
+	}
+	if len(june.Lines) != 0 || len(doc.Months[0].Rects) != 28 {
+		t.Errorf("Unexpected notes or cells in %+v\n", doc.Months[0])
+	}
+}
+
+func TestSVGRendererMonthWithNotes(t *testing.T) {
+	months := fmt.LayoutMonths(cal.NewIFCDate(2021, cal.December, 1), 1, nil, nil, nil)
+	doc := renderSVG(t, fmt.SVGRenderer{Paper: fmt.Letter().Landscape(), Notes: true}, months)
+
+	if doc.Width != "279.4mm" || doc.Height != "215.9mm" {
+		t.Errorf("Expected landscape Letter paper but found %s x %s\n", doc.Width, doc.Height)
+	}
+	if len(doc.Months) != 1 || len(doc.Months[0].Lines) == 0 {
+		t.Fatalf("Expected a month with notes but found %+v\n", doc.Months)
+	}
+	for _, text := range doc.Months[0].Texts {
+		if text.Class == "weekday" && text.Text == "YD" {
+			return
+		}
+	}
+	t.Errorf("Expected a Year Day column in %+v\n", doc.Months[0].Texts)
+}
+
+func TestSVGRendererWithAnnotations(t *testing.T) {
+	opts := &fmt.Options{Annotations: []fmt.AnnotatedDays{{Kind: fmt.HolidayAnnotation, Days: cal.FinnishHolidays}}}
+	months := fmt.LayoutMonths(cal.NewIFCDate(2022, cal.December, 1), 1, nil, nil, opts)
+	doc := renderSVG(t, fmt.SVGRenderer{}, months)
+
+	for _, text := range doc.Months[0].Texts {
+		if text.Class == "annotation holiday" && text.Text == "Christmas Day" {
+			return
+		}
+	}
+	t.Errorf("Expected Christmas Day in %+v\n", doc.Months[0].Texts)
+}
+
+func TestLookupPaperSize(t *testing.T) {
+	if paper, ok := fmt.LookupPaperSize("a3"); !ok || paper != fmt.A3() {
+		t.Errorf("Expected A3 but found %+v\n", paper)
+	}
+	if _, ok := fmt.LookupPaperSize("b5"); ok {
+		t.Errorf("Expected B5 to be unknown\n")
+	}
+	if paper := fmt.A4().Landscape().Landscape(); paper.Width != 297 || !strings.EqualFold(paper.Name, "a4") {
+		t.Errorf("Expected landscape A4 but found %+v\n", paper)
+	}
+}
@@ -0,0 +1,115 @@
+package fmt
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// wideRanges are the East Asian Wide and Fullwidth ranges of Unicode,
+// whose characters take two columns in a terminal.
+var wideRanges = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x1100, Hi: 0x115f, Stride: 1},
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x2329, Hi: 0x232a, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23ec, Stride: 1},
+		{Lo: 0x2e80, Hi: 0x303e, Stride: 1},
+		{Lo: 0x3041, Hi: 0x33ff, Stride: 1},
+		{Lo: 0x3400, Hi: 0x4dbf, Stride: 1},
+		{Lo: 0x4e00, Hi: 0x9fff, Stride: 1},
+		{Lo: 0xa000, Hi: 0xa4cf, Stride: 1},
+		{Lo: 0xa960, Hi: 0xa97f, Stride: 1},
+		{Lo: 0xac00, Hi: 0xd7a3, Stride: 1},
+		{Lo: 0xf900, Hi: 0xfaff, Stride: 1},
+		{Lo: 0xfe10, Hi: 0xfe19, Stride: 1},
+		{Lo: 0xfe30, Hi: 0xfe6f, Stride: 1},
+		{Lo: 0xff00, Hi: 0xff60, Stride: 1},
+		{Lo: 0xffe0, Hi: 0xffe6, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x16fe0, Hi: 0x16fe4, Stride: 1},
+		{Lo: 0x17000, Hi: 0x18cff, Stride: 1},
+		{Lo: 0x1b000, Hi: 0x1b2ff, Stride: 1},
+		{Lo: 0x1f300, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f900, Hi: 0x1f9ff, Stride: 1},
+		{Lo: 0x20000, Hi: 0x2fffd, Stride: 1},
+		{Lo: 0x30000, Hi: 0x3fffd, Stride: 1},
+	},
+}
+
+// runeWidth returns the number of terminal columns a character takes:
+// none for combining marks, format and control characters, two for wide
+// East Asian characters and one for the rest.
+func runeWidth(r rune) int {
+	switch {
+	case r == 0, unicode.IsControl(r), unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case r >= 0x1160 && r <= 0x11ff:
+		// Hangul medial vowels and final consonants join the preceding
+		// initial consonant.
+		return 0
+	case unicode.Is(wideRanges, r):
+		return 2
+	}
+	return 1
+}
+
+// displayWidth returns the number of columns a text occupies in a
+// terminal, ignoring highlight escape sequences.
+func displayWidth(text string) int {
+	width := 0
+	inEscape := false
+	for _, r := range text {
+		switch {
+		case r == '\033':
+			inEscape = true
+		case inEscape:
+			if r == 'm' {
+				inEscape = false
+			}
+		case r >= ' ' && r < utf8.RuneSelf-1:
+			// Printable ASCII is common enough to skip the tables.
+			width++
+		default:
+			width += runeWidth(r)
+		}
+	}
+	return width
+}
+
+// padRight pads a text with spaces to the given display width.
+func padRight(text string, width int) string {
+	if padding := width - displayWidth(text); padding > 0 {
+		return text + strings.Repeat(" ", padding)
+	}
+	return text
+}
+
+// appendPadded writes a text padded with spaces to the given display
+// width.
+func appendPadded(buf []byte, text string, width int) []byte {
+	buf = append(buf, text...)
+	return appendSpaces(buf, width-displayWidth(text))
+}
+
+// spaces is long enough for the padding of most cells and titles.
+const spaces = "                                "
+
+func appendSpaces(buf []byte, n int) []byte {
+	for ; n > len(spaces); n -= len(spaces) {
+		buf = append(buf, spaces...)
+	}
+	if n > 0 {
+		buf = append(buf, spaces[:n]...)
+	}
+	return buf
+}
+
+func appendRune(buf []byte, r rune) []byte {
+	if r < utf8.RuneSelf {
+		return append(buf, byte(r))
+	}
+	var encoded [utf8.UTFMax]byte
+	return append(buf, encoded[:utf8.EncodeRune(encoded[:], r)]...)
+}
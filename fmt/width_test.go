@@ -0,0 +1,130 @@
+package fmt_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Lateks/cotsworth/cal"
+	"github.com/Lateks/cotsworth/fmt"
+)
+
+var chinese, _ = cal.NewLocale(cal.LocaleData{
+	Tag: "zh",
+	MonthNames: []string{
+		"一月", "二月", "三月", "四月", "五月", "六月", "太阳月",
+		"七月", "八月", "九月", "十月", "十一月", "十二月",
+	},
+	ShortMonthNames: []string{
+		"一月", "二月", "三月", "四月", "五月", "六月", "太阳",
+		"七月", "八月", "九月", "十月", "十一月", "十二月",
+	},
+	WeekdayNames: []string{
+		"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六",
+		"闰日", "年终日",
+	},
+	ShortWeekdayNames: []string{"日", "一", "二", "三", "四", "五", "六", "闰", "年"},
+})
+
+// decomposedFrench spells the accented French names with combining marks.
+var decomposedFrench, _ = cal.NewLocale(cal.LocaleData{
+	Tag: "fr",
+	MonthNames: []string{
+		"janvier", "février", "mars", "avril", "mai", "juin", "sol",
+		"juillet", "août", "septembre", "octobre", "novembre", "décembre",
+	},
+	ShortMonthNames: []string{
+		"janv.", "févr.", "mars", "avr.", "mai", "juin", "sol",
+		"juil.", "août", "sept.", "oct.", "nov.", "déc.",
+	},
+	WeekdayNames: []string{
+		"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi",
+		"jour bissextile", "jour de l'année",
+	},
+	ShortWeekdayNames: []string{"di", "lu", "ma", "me", "je", "ve", "sa", "JB", "JÂ"},
+})
+
+func TestCenteringWideAndCombiningText(t *testing.T) {
+	for i, input := range []struct {
+		text       string
+		fieldWidth int
+		result     string
+	}{
+		{"ソル月 2024", 24, "      ソル月 2024       "},
+		{"太阳月 2024", 21, "     太阳月 2024     "},
+		{"décembre 2021", 24, "     décembre 2021      "},
+		{"zero​width", 12, " zero​width  "},
+		{"十二月 2024", 4, "十二月 2024"},
+	} {
+		formatted := fmt.CenterInField(input.text, input.fieldWidth)
+		if formatted != input.result {
+			t.Errorf("%d: Expected '%s' but found '%s'\n", i, input.result, formatted)
+		}
+	}
+}
+
+func TestMonthFormattingInWideAndCombiningLocales(t *testing.T) {
+	for i, input := range []struct {
+		locale   *cal.Locale
+		month    cal.IFCMonth
+		expected []string
+	}{
+		{
+			cal.JapaneseLocale(),
+			cal.Sol,
+			[]string{
+				"      ソル月 2024       ",
+				"日 月 火 水 木 金 土    ",
+				" 1  2  3  4  5  6  7    ",
+			},
+		},
+		{
+			chinese,
+			cal.June,
+			[]string{
+				"       六月 2024        ",
+				"日 一 二 三 四 五 六 闰 ",
+				" 1  2  3  4  5  6  7    ",
+			},
+		},
+		{
+			decomposedFrench,
+			cal.December,
+			[]string{
+				"     décembre 2024      ",
+				"di lu ma me je ve sa JÂ ",
+				" 1  2  3  4  5  6  7    ",
+			},
+		},
+	} {
+		lines := fmt.MonthToLinesWithOptions(2024, input.month, nil, &fmt.Options{Locale: input.locale})
+		for j, line := range input.expected {
+			if lines[j] != line {
+				t.Errorf("%d, %d: Expected '%s' but found '%s'\n", i, j, line, lines[j])
+			}
+		}
+	}
+}
+
+func TestRelationViewInJapanese(t *testing.T) {
+	labeler := fmt.GregorianLabeler(cal.JapaneseLocale())
+	lines := fmt.MonthToLinesWithCalendar(2024, cal.February, nil, labeler, &fmt.Options{Locale: cal.JapaneseLocale()})
+
+	expected := strings.Repeat(" ", 9) + "2月" + strings.Repeat(" ", 28*3-12)
+	if lines[5] != expected {
+		t.Errorf("Expected month line '%s' but found '%s'\n", expected, lines[5])
+	}
+	expected = "月 火 水 木 金 土 日 "
+	if !strings.HasPrefix(lines[4], expected) {
+		t.Errorf("Expected Gregorian weekdays to start with '%s' but found '%s'\n", expected, lines[4])
+	}
+}
+
+func TestRelationViewInJapaneseAfterSol(t *testing.T) {
+	labeler := fmt.GregorianLabeler(cal.JapaneseLocale())
+	lines := fmt.MonthToLinesWithCalendar(2024, cal.Sol, nil, labeler, &fmt.Options{Locale: cal.JapaneseLocale()})
+
+	expected := strings.Repeat(" ", 13*3) + "7月"
+	if strings.TrimRight(lines[5], " ") != expected {
+		t.Errorf("Expected month line '%s' but found '%s'\n", expected, lines[5])
+	}
+}